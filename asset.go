@@ -0,0 +1,449 @@
+package topdesk
+
+// Asset management.
+//
+// https://developers.topdesk.com/explorer/?page=assets
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type AssetIterator struct {
+	*ListIterator
+}
+
+// Row decodes the listed asset without fetching it.
+func (i AssetIterator) Row() (*AssetRow, error) {
+	response := &AssetRow{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Asset fetches the listed asset in full.
+//
+// List rows don't include the template schema or field values beyond ListAssetsRequest.Fields, those are only
+// returned by GetAsset. Use Row when the ID, name and requested fields are enough, it saves a request per asset.
+func (i AssetIterator) Asset() (*Asset, error) {
+	row, err := i.Row()
+	if err != nil {
+		return nil, err
+	}
+	return i.client.GetAsset(i.ctx, row.ID)
+}
+
+// AssetRow is an asset as listed, Fields holds the raw values of ListAssetsRequest.Fields.
+type AssetRow struct {
+	ID       string
+	Name     string
+	Archived bool
+	Fields   map[string]json.RawMessage
+}
+
+func (r *AssetRow) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &r.Fields); err != nil {
+		return err
+	}
+	_ = json.Unmarshal(r.Fields["id"], &r.ID)
+	_ = json.Unmarshal(r.Fields["text"], &r.Name)
+	_ = json.Unmarshal(r.Fields["archived"], &r.Archived)
+	return nil
+}
+
+func (r AssetRow) Ref() *Ref {
+	return &Ref{ID: r.ID}
+}
+
+// AssetFieldType is the template schema type of an asset field.
+type AssetFieldType string
+
+const (
+	AssetFieldText      AssetFieldType = "text"
+	AssetFieldMemo      AssetFieldType = "memo"
+	AssetFieldNumber    AssetFieldType = "number"
+	AssetFieldBoolean   AssetFieldType = "boolean"
+	AssetFieldDate      AssetFieldType = "date"
+	AssetFieldDropdown  AssetFieldType = "dropdown"
+	AssetFieldReference AssetFieldType = "reference"
+)
+
+// AssetField definition from an asset template.
+type AssetField struct {
+	Name        string         `json:"-"`
+	DisplayName string         `json:"displayName"`
+	Type        AssetFieldType `json:"type"`
+	Required    bool           `json:"required"`
+	ReadOnly    bool           `json:"readOnly"`
+}
+
+// Asset is a template driven asset.
+//
+// The fields an asset has depend on its template, use the typed accessors to read them. Each accessor checks the
+// field type against the template schema returned with the asset.
+type Asset struct {
+	ID         string
+	Name       string
+	TemplateID string
+	Archived   bool
+
+	data   map[string]json.RawMessage
+	fields map[string]AssetField
+}
+
+func (a *Asset) UnmarshalJSON(b []byte) error {
+	response := struct {
+		Data   map[string]json.RawMessage `json:"data"`
+		Fields map[string]AssetField      `json:"fields"`
+		// Metadata is only partially decoded.
+		Metadata struct {
+			TemplateID string `json:"templateId"`
+		} `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(b, &response); err != nil {
+		return err
+	}
+
+	a.data = response.Data
+	a.fields = response.Fields
+	for name, field := range a.fields {
+		field.Name = name
+		a.fields[name] = field
+	}
+
+	a.TemplateID = response.Metadata.TemplateID
+	if raw, ok := a.data["type_id"]; ok && a.TemplateID == "" {
+		_ = json.Unmarshal(raw, &a.TemplateID)
+	}
+	_ = json.Unmarshal(a.data["id"], &a.ID)
+	_ = json.Unmarshal(a.data["name"], &a.Name)
+	_ = json.Unmarshal(a.data["archived"], &a.Archived)
+	return nil
+}
+
+func (a Asset) Ref() *Ref {
+	return &Ref{ID: a.ID}
+}
+
+func (a Asset) RelativeURL() *url.URL {
+	uri, _ := url.Parse("/tas/secure/assetmgmt/card.html")
+	query := url.Values{}
+	query.Set("unid", a.ID)
+	uri.RawQuery = query.Encode()
+	return uri
+}
+
+// FieldNames in the template schema, sorted.
+func (a Asset) FieldNames() []string {
+	names := make([]string, 0, len(a.fields))
+	for name := range a.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Field definition from the template schema.
+func (a Asset) Field(name string) (AssetField, bool) {
+	field, ok := a.fields[name]
+	return field, ok
+}
+
+// Has is true if the field has a non-null value.
+func (a Asset) Has(name string) bool {
+	raw, ok := a.data[name]
+	return ok && string(raw) != "null"
+}
+
+func (a Asset) raw(name string, types ...AssetFieldType) (json.RawMessage, error) {
+	field, ok := a.fields[name]
+	if !ok {
+		return nil, errors.Errorf("asset %s field %s not in template", a.ID, name)
+	}
+
+	for _, t := range types {
+		if field.Type == t {
+			return a.data[name], nil
+		}
+	}
+	return nil, errors.Errorf("asset %s field %s is %s not %s", a.ID, name, field.Type, types[0])
+}
+
+// String value of a text or memo field.
+func (a Asset) String(name string) (string, error) {
+	raw, err := a.raw(name, AssetFieldText, AssetFieldMemo)
+	if err != nil || raw == nil {
+		return "", err
+	}
+
+	value := ""
+	err = json.Unmarshal(raw, &value)
+	return value, errors.Wrapf(err, "asset %s field %s", a.ID, name)
+}
+
+// Number value of a number field.
+func (a Asset) Number(name string) (float64, error) {
+	raw, err := a.raw(name, AssetFieldNumber)
+	if err != nil || raw == nil {
+		return 0, err
+	}
+
+	value := json.Number("")
+	if err := json.Unmarshal(raw, &value); err != nil || value == "" {
+		return 0, errors.Wrapf(err, "asset %s field %s", a.ID, name)
+	}
+	number, err := value.Float64()
+	return number, errors.Wrapf(err, "asset %s field %s", a.ID, name)
+}
+
+// Bool value of a boolean field.
+func (a Asset) Bool(name string) (bool, error) {
+	raw, err := a.raw(name, AssetFieldBoolean)
+	if err != nil || raw == nil {
+		return false, err
+	}
+
+	value := false
+	err = json.Unmarshal(raw, &value)
+	return value, errors.Wrapf(err, "asset %s field %s", a.ID, name)
+}
+
+// Time value of a date field, the zero time if empty.
+func (a Asset) Time(name string) (time.Time, error) {
+	raw, err := a.raw(name, AssetFieldDate)
+	if err != nil || raw == nil {
+		return time.Time{}, err
+	}
+
+	value := ""
+	if err := json.Unmarshal(raw, &value); err != nil || value == "" {
+		return time.Time{}, errors.Wrapf(err, "asset %s field %s", a.ID, name)
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	return t, errors.Wrapf(err, "asset %s field %s", a.ID, name)
+}
+
+// Ref value of a dropdown or reference field, nil if empty.
+func (a Asset) RefField(name string) (*Ref, error) {
+	raw, err := a.raw(name, AssetFieldDropdown, AssetFieldReference)
+	if err != nil || raw == nil || string(raw) == "null" {
+		return nil, err
+	}
+
+	// Single references are returned as a bare ID by some field types.
+	id := ""
+	if err := json.Unmarshal(raw, &id); err == nil {
+		return &Ref{ID: id}, nil
+	}
+	value := &Ref{}
+	err = json.Unmarshal(raw, value)
+	return value, errors.Wrapf(err, "asset %s field %s", a.ID, name)
+}
+
+// Value of any field decoded according to the template schema type.
+func (a Asset) Value(name string) (interface{}, error) {
+	field, ok := a.fields[name]
+	if !ok {
+		return nil, errors.Errorf("asset %s field %s not in template", a.ID, name)
+	}
+
+	switch field.Type {
+	case AssetFieldText, AssetFieldMemo:
+		return a.String(name)
+	case AssetFieldNumber:
+		return a.Number(name)
+	case AssetFieldBoolean:
+		return a.Bool(name)
+	case AssetFieldDate:
+		return a.Time(name)
+	case AssetFieldDropdown, AssetFieldReference:
+		return a.RefField(name)
+	default:
+		var value interface{}
+		err := json.Unmarshal(a.data[name], &value)
+		return value, errors.Wrapf(err, "asset %s field %s", a.ID, name)
+	}
+}
+
+// ListAssetsRequest filters assets, empty fields are ignored.
+//
+// Filter is an OData style expression e.g. `name eq 'Printer 1'`. Fields adds fields to each list item.
+//
+// LinkedTo limits the list to assets assigned to a person, branch, location or person group, see AssetLinkedTo.
+type ListAssetsRequest struct {
+	TemplateID string
+	LinkedTo   string
+	Archived   *bool
+	Filter     string
+	Fields     []string
+}
+
+func (rc RestClient) ListAssets(ctx context.Context, request *ListAssetsRequest) (*AssetIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets")
+
+	if request != nil {
+		query := uri.Query()
+		if request.TemplateID != "" {
+			query.Set("templateId", request.TemplateID)
+		}
+		if request.LinkedTo != "" {
+			query.Set("linkedTo", request.LinkedTo)
+		}
+		if request.Archived != nil {
+			query.Set("archived", strconv.FormatBool(*request.Archived))
+		}
+		if request.Filter != "" {
+			query.Set("$filter", request.Filter)
+		}
+		if len(request.Fields) > 0 {
+			query.Set("fields", strings.Join(request.Fields, ","))
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.listEnvelope(ctx, &uri, "dataSet", "$skip", "$top")
+	return &AssetIterator{it}, err
+}
+
+func (rc RestClient) GetAsset(ctx context.Context, id string) (*Asset, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets", id)
+
+	response := &Asset{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateAssetRequest creates an asset from a template.
+//
+// Fields are keyed by template field name, dropdown and reference fields take the ID of the option.
+type CreateAssetRequest struct {
+	TemplateID string
+	Name       string
+	Fields     map[string]interface{}
+}
+
+func (r CreateAssetRequest) MarshalJSON() ([]byte, error) {
+	body := map[string]interface{}{}
+	for name, value := range r.Fields {
+		body[name] = value
+	}
+	body["type_id"] = r.TemplateID
+	if r.Name != "" {
+		body["name"] = r.Name
+	}
+	return json.Marshal(body)
+}
+
+func (rc RestClient) CreateAsset(ctx context.Context, request *CreateAssetRequest) (*Asset, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets")
+
+	response := &Asset{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateAssetRequest patches the asset fields keyed by template field name.
+type UpdateAssetRequest struct {
+	ID     string
+	Fields map[string]interface{}
+}
+
+func (rc RestClient) UpdateAsset(ctx context.Context, request *UpdateAssetRequest) (*Asset, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets", request.ID)
+
+	response := &Asset{}
+	if err := rc.patch(ctx, &uri, request.Fields, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ArchiveAssetRequest archives an asset, ReasonID is an ArchivingReason ID.
+type ArchiveAssetRequest struct {
+	ID       string `json:"-"`
+	ReasonID string `json:"reasonId,omitempty"`
+}
+
+func (rc RestClient) ArchiveAsset(ctx context.Context, request *ArchiveAssetRequest) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets", request.ID, "archive")
+
+	return rc.create(ctx, &uri, request, &json.RawMessage{})
+}
+
+func (rc RestClient) UnarchiveAsset(ctx context.Context, id string) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets", id, "unarchive")
+
+	return rc.create(ctx, &uri, nil, &json.RawMessage{})
+}
+
+// AssetTemplate (asset type) with its field definitions.
+type AssetTemplate struct {
+	ID     string                `json:"id"`
+	Name   string                `json:"text"`
+	Fields map[string]AssetField `json:"-"`
+}
+
+// ListAssetTemplates without field definitions, see GetAssetTemplate.
+func (rc RestClient) ListAssetTemplates(ctx context.Context) ([]AssetTemplate, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "templates")
+
+	response := struct {
+		DataSet []AssetTemplate `json:"dataSet"`
+	}{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response.DataSet, nil
+}
+
+// GetAssetTemplate with its field definitions.
+func (rc RestClient) GetAssetTemplate(ctx context.Context, id string) (*AssetTemplate, error) {
+	templates, err := rc.ListAssetTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var template *AssetTemplate
+	for i := range templates {
+		if templates[i].ID == id {
+			template = &templates[i]
+		}
+	}
+	if template == nil {
+		return nil, NotFoundError{Resource: "asset template", Query: "id " + id}
+	}
+
+	// A blank asset carries the template schema.
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets", "blank")
+	query := uri.Query()
+	query.Set("templateId", id)
+	uri.RawQuery = query.Encode()
+
+	blank := &Asset{}
+	if err := rc.get(ctx, &uri, blank); err != nil {
+		return nil, err
+	}
+	template.Fields = blank.fields
+	return template, nil
+}
@@ -0,0 +1,142 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+)
+
+// AssetAssignmentType is the kind of record an asset is assigned to.
+type AssetAssignmentType string
+
+const (
+	AssetAssignmentPerson      AssetAssignmentType = "person"
+	AssetAssignmentBranch      AssetAssignmentType = "branch"
+	AssetAssignmentLocation    AssetAssignmentType = "location"
+	AssetAssignmentPersonGroup AssetAssignmentType = "personGroup"
+)
+
+// AssetLinkedTo builds a ListAssetsRequest.LinkedTo filter e.g. all assets held by a person.
+func AssetLinkedTo(assignment AssetAssignmentType, id string) string {
+	return string(assignment) + "/" + id
+}
+
+// AssetAssignment of an asset to a person, branch, location or person group.
+//
+// LinkID identifies the assignment itself and is required to remove it.
+type AssetAssignment struct {
+	LinkID string `json:"linkId"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Branch struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"branch"`
+}
+
+func (a AssetAssignment) Ref() *Ref {
+	return &Ref{ID: a.ID}
+}
+
+// AssetAssignments grouped by type.
+type AssetAssignments struct {
+	Persons      []AssetAssignment `json:"persons"`
+	Branches     []AssetAssignment `json:"branches"`
+	Locations    []AssetAssignment `json:"locations"`
+	PersonGroups []AssetAssignment `json:"personGroups"`
+}
+
+func (rc RestClient) ListAssetAssignments(ctx context.Context, id string) (*AssetAssignments, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets", id, "assignments")
+
+	response := &AssetAssignments{}
+	if err := rc.getAll(ctx, &uri, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// AddAssetAssignmentRequest assigns an asset, AssigneeID is the Person, Branch, Location or PersonGroup ID.
+type AddAssetAssignmentRequest struct {
+	AssetID    string              `json:"-"`
+	Type       AssetAssignmentType `json:"linkType"`
+	AssigneeID string              `json:"linkToId"`
+}
+
+func (rc RestClient) AddAssetAssignment(ctx context.Context, request *AddAssetAssignmentRequest) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets", request.AssetID, "assignments")
+
+	return rc.update(ctx, &uri, request, &json.RawMessage{})
+}
+
+func (rc RestClient) RemoveAssetAssignment(ctx context.Context, assetID string, linkID string) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assets", assetID, "assignments", linkID)
+
+	return rc.delete(ctx, &uri, nil)
+}
+
+// ListPersonAssets lists the assets assigned to a person e.g. to collect equipment when they leave.
+func (rc RestClient) ListPersonAssets(ctx context.Context, personID string) (*AssetIterator, error) {
+	return rc.ListAssets(ctx, &ListAssetsRequest{LinkedTo: AssetLinkedTo(AssetAssignmentPerson, personID)})
+}
+
+// AssetLinkType is the direction of an asset to asset link.
+type AssetLinkType string
+
+const (
+	AssetLinkParent AssetLinkType = "parent"
+	AssetLinkChild  AssetLinkType = "child"
+	AssetLinkPeer   AssetLinkType = "peer"
+)
+
+// AssetLink between two assets, e.g. a monitor (child) connected to a workstation (parent).
+type AssetLink struct {
+	ID       string        `json:"id"`
+	SourceID string        `json:"sourceId"`
+	TargetID string        `json:"targetId"`
+	Type     AssetLinkType `json:"type"`
+	Name     string        `json:"name"`
+}
+
+func (rc RestClient) ListAssetLinks(ctx context.Context, id string) ([]AssetLink, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assetLinks")
+	query := uri.Query()
+	query.Set("sourceId", id)
+	uri.RawQuery = query.Encode()
+
+	response := []AssetLink{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// AddAssetLinkRequest links the target asset to the source asset, Type is relative to the source.
+type AddAssetLinkRequest struct {
+	SourceID string        `json:"sourceId"`
+	TargetID string        `json:"targetId"`
+	Type     AssetLinkType `json:"type"`
+}
+
+func (rc RestClient) AddAssetLink(ctx context.Context, request *AddAssetLinkRequest) (*AssetLink, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assetLinks")
+
+	response := &AssetLink{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (rc RestClient) RemoveAssetLink(ctx context.Context, linkID string) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "assetmgmt", "assetLinks", linkID)
+
+	return rc.delete(ctx, &uri, nil)
+}
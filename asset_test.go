@@ -0,0 +1,45 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestAssetIteratorRow(t *testing.T) {
+	client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tas/api/assetmgmt/assets" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"dataSet": []map[string]interface{}{
+				{"id": "a1", "text": "Printer 1", "archived": false, "serial": "S1"},
+			},
+		})
+	})
+	defer server.Close()
+
+	assets, err := client.ListAssets(context.Background(), &ListAssetsRequest{Fields: []string{"serial"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assets.Next() {
+		t.Fatalf("no rows: %v", assets.Err())
+	}
+	row, err := assets.Row()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.ID != "a1" || row.Name != "Printer 1" || row.Archived || string(row.Fields["serial"]) != `"S1"` {
+		t.Errorf("got %+v", row)
+	}
+	if assets.Next() {
+		t.Error("got a second row")
+	}
+	if err := assets.Err(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,289 @@
+package topdesk
+
+// Change management.
+//
+// https://developers.topdesk.com/explorer/?page=change
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+)
+
+type ChangeIterator struct {
+	*ListIterator
+}
+
+// Change decodes the listed change, only the requested fields are set when ListChangesRequest.Fields is used.
+func (i ChangeIterator) Change() (*Change, error) {
+	response := &Change{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type ChangeType string
+
+const (
+	ChangeTypeSimple    ChangeType = "simple"
+	ChangeTypeExtensive ChangeType = "extensive"
+)
+
+type ChangePhase string
+
+const (
+	ChangePhaseRequestForChange ChangePhase = "rfc"
+	ChangePhaseProgress         ChangePhase = "progress"
+	ChangePhaseEvaluation       ChangePhase = "evaluation"
+)
+
+type ChangeApprovalStatus string
+
+const (
+	ChangeApprovalNotApplicable ChangeApprovalStatus = "not_applicable"
+	ChangeApprovalPending       ChangeApprovalStatus = "pending"
+	ChangeApprovalApproved      ChangeApprovalStatus = "approved"
+	ChangeApprovalRejected      ChangeApprovalStatus = "rejected"
+)
+
+// ChangeProgress of a single change phase.
+type ChangeProgress struct {
+	Status struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"status"`
+	Manager struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"manager"`
+	PlannedStartDate string `json:"plannedStartDate"`
+	PlannedEndDate   string `json:"plannedEndDate"`
+	StartDate        string `json:"startDate"`
+	EndDate          string `json:"endDate"`
+	Approval         struct {
+		Status ChangeApprovalStatus `json:"status"`
+		Date   string               `json:"date"`
+		Reason string               `json:"reason"`
+	} `json:"approval"`
+}
+
+type Change struct {
+	ID           string      `json:"id"`
+	Number       string      `json:"number"`
+	Type         ChangeType  `json:"changeType"`
+	CurrentPhase ChangePhase `json:"currentPhase"`
+	Status       struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"status"`
+	Template struct {
+		ID               string `json:"id"`
+		Number           string `json:"number"`
+		BriefDescription string `json:"briefDescription"`
+	} `json:"template"`
+	Requester struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"requester"`
+	BriefDescription string `json:"briefDescription"`
+	Request          string `json:"request"`
+	Action           string `json:"action"`
+	ExternalNumber   string `json:"externalNumber"`
+	Category         struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"category"`
+	Subcategory struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"subcategory"`
+	Impact struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"impact"`
+	Benefit struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"benefit"`
+	Priority struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"priority"`
+	Phases struct {
+		RequestForChange ChangeProgress `json:"rfc"`
+		Progress         ChangeProgress `json:"progress"`
+		Evaluation       ChangeProgress `json:"evaluation"`
+	} `json:"phases"`
+	Closed           bool   `json:"closed"`
+	ClosedDate       string `json:"closedDate"`
+	Canceled         bool   `json:"canceled"`
+	CreationDate     string `json:"creationDate"`
+	ModificationDate string `json:"modificationDate"`
+}
+
+func (c Change) Ref() *Ref {
+	return &Ref{ID: c.ID}
+}
+
+func (c Change) RelativeURL() *url.URL {
+	uri, _ := url.Parse("/tas/secure/contained/newchange")
+	query := url.Values{}
+	query.Set("action", "show")
+	query.Set("unid", c.ID)
+	uri.RawQuery = query.Encode()
+	return uri
+}
+
+// Approval state of the current phase, empty once the change is past the approvable phases.
+func (c Change) Approval() ChangeApprovalStatus {
+	return c.PhaseApproval(c.CurrentPhase)
+}
+
+// PhaseApproval state of a phase, empty for unknown phases.
+func (c Change) PhaseApproval(phase ChangePhase) ChangeApprovalStatus {
+	switch phase {
+	case ChangePhaseRequestForChange:
+		return c.Phases.RequestForChange.Approval.Status
+	case ChangePhaseProgress:
+		return c.Phases.Progress.Approval.Status
+	case ChangePhaseEvaluation:
+		return c.Phases.Evaluation.Approval.Status
+	}
+	return ""
+}
+
+// ListChangesRequest filters changes, empty fields are ignored.
+//
+// Query is a FIQL expression and'ed with the other filters.
+type ListChangesRequest struct {
+	ExternalNumber string
+	Type           ChangeType
+	CurrentPhase   ChangePhase
+	StatusID       string
+	RequesterID    string
+	Query          string
+	Sort           []string
+	Fields         []string
+}
+
+func (rc RestClient) ListChanges(ctx context.Context, request *ListChangesRequest) (*ChangeIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operatorChanges")
+
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.
+			eq("externalNumber", request.ExternalNumber).
+			eq("changeType", string(request.Type)).
+			eq("currentPhase", string(request.CurrentPhase)).
+			eq("status.id", request.StatusID).
+			eq("requester.id", request.RequesterID).
+			and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		if len(request.Sort) > 0 {
+			query.Set("sort", strings.Join(request.Sort, ","))
+		}
+		if len(request.Fields) > 0 {
+			query.Set("fields", strings.Join(request.Fields, ","))
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.listEnvelope(ctx, &uri, "results", "pageStart", "pageSize")
+	return &ChangeIterator{it}, err
+}
+
+// GetChange by ID or number.
+func (rc RestClient) GetChange(ctx context.Context, id string) (*Change, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operatorChanges", id)
+
+	response := &Change{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateChangeRequest creates a change from the perspective of an operator.
+//
+// Template is a simple or extensive change template ref, Topdesk takes the change type from it.
+//
+// See https://developers.topdesk.com/explorer/?page=change#/Working%20as%20an%20operator/createChange
+type CreateChangeRequest struct {
+	Requester        *Ref       `json:"requester"`
+	Template         *Ref       `json:"template"`
+	Type             ChangeType `json:"changeType,omitempty"`
+	BriefDescription string     `json:"briefDescription,omitempty"`
+	Request          string     `json:"request,omitempty"`
+	Action           string     `json:"action,omitempty"`
+	ExternalNumber   string     `json:"externalNumber,omitempty"`
+	Category         *Ref       `json:"category,omitempty"`
+	Subcategory      *Ref       `json:"subcategory,omitempty"`
+	Impact           *Ref       `json:"impact,omitempty"`
+	Benefit          *Ref       `json:"benefit,omitempty"`
+	Priority         *Ref       `json:"priority,omitempty"`
+}
+
+func (rc RestClient) CreateChange(ctx context.Context, request *CreateChangeRequest) (*Change, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operatorChanges")
+
+	response := &Ref{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return rc.GetChange(ctx, response.ID)
+}
+
+// CreateRequestForChangeRequest creates a request for change from the perspective of a person (requester).
+//
+// See https://developers.topdesk.com/explorer/?page=change#/Working%20as%20a%20requester/createRequesterChange
+type CreateRequestForChangeRequest struct {
+	Template         *Ref   `json:"template,omitempty"`
+	BriefDescription string `json:"briefDescription"`
+	Request          string `json:"request,omitempty"`
+	ExternalNumber   string `json:"externalNumber,omitempty"`
+}
+
+func (rc RestClient) CreateRequestForChange(ctx context.Context, request *CreateRequestForChangeRequest) (*Change, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "requesterChanges")
+
+	response := &Ref{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return rc.GetChange(ctx, response.ID)
+}
+
+// UpdateChangeRequest patches a change, only non-zero fields are sent.
+type UpdateChangeRequest struct {
+	ID               string `json:"-"`
+	BriefDescription string `json:"briefDescription,omitempty"`
+	Request          string `json:"request,omitempty"`
+	Action           string `json:"action,omitempty"`
+	ExternalNumber   string `json:"externalNumber,omitempty"`
+	Category         *Ref   `json:"category,omitempty"`
+	Subcategory      *Ref   `json:"subcategory,omitempty"`
+	Impact           *Ref   `json:"impact,omitempty"`
+	Benefit          *Ref   `json:"benefit,omitempty"`
+	Priority         *Ref   `json:"priority,omitempty"`
+	Status           *Ref   `json:"status,omitempty"`
+}
+
+func (rc RestClient) UpdateChange(ctx context.Context, request *UpdateChangeRequest) (*Change, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operatorChanges", request.ID)
+
+	response := &Change{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,267 @@
+package topdesk
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type ChangeActivityIterator struct {
+	*ListIterator
+}
+
+// ChangeActivity decodes the listed change activity.
+func (i ChangeActivityIterator) ChangeActivity() (*ChangeActivity, error) {
+	response := &ChangeActivity{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type ChangeActivityType string
+
+const (
+	ChangeActivityTypeNormal        ChangeActivityType = "normal"
+	ChangeActivityTypeAuthorization ChangeActivityType = "authorization"
+)
+
+// ChangeActivity is a task within a change, authorization activities are the change approvals.
+type ChangeActivity struct {
+	ID     string `json:"id"`
+	Number string `json:"number"`
+	Change struct {
+		ID     string `json:"id"`
+		Number string `json:"number"`
+	} `json:"change"`
+	ActivityType     ChangeActivityType `json:"activityType"`
+	ChangePhase      ChangePhase        `json:"changePhase"`
+	BriefDescription string             `json:"briefDescription"`
+	Request          string             `json:"request"`
+	Action           string             `json:"action"`
+	Status           struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"status"`
+	Operator struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"operator"`
+	OperatorGroup struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"operatorGroup"`
+	PlannedStartDate string `json:"plannedStartDate"`
+	PlannedFinalDate string `json:"plannedFinalDate"`
+	Resolved         bool   `json:"resolved"`
+	ResolvedDate     string `json:"resolvedDate"`
+	Skipped          bool   `json:"skipped"`
+	Approval         struct {
+		Status ChangeApprovalStatus `json:"status"`
+		Date   string               `json:"date"`
+		Reason string               `json:"reason"`
+	} `json:"approval"`
+	CreationDate     string `json:"creationDate"`
+	ModificationDate string `json:"modificationDate"`
+}
+
+func (a ChangeActivity) Ref() *Ref {
+	return &Ref{ID: a.ID}
+}
+
+// ListChangeActivitiesRequest filters change activities, empty fields are ignored.
+//
+// Query is a FIQL expression and'ed with the other filters.
+type ListChangeActivitiesRequest struct {
+	ChangeID        string
+	ActivityType    ChangeActivityType
+	OperatorID      string
+	OperatorGroupID string
+	Resolved        *bool
+	Query           string
+	Sort            []string
+}
+
+func (rc RestClient) ListChangeActivities(ctx context.Context, request *ListChangeActivitiesRequest) (*ChangeActivityIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operatorChangeActivities")
+
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.
+			eq("change.id", request.ChangeID).
+			eq("activityType", string(request.ActivityType)).
+			eq("operator.id", request.OperatorID).
+			eq("operatorGroup.id", request.OperatorGroupID)
+		if request.Resolved != nil {
+			filter = filter.eq("resolved", strconv.FormatBool(*request.Resolved))
+		}
+		filter = filter.and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		if len(request.Sort) > 0 {
+			query.Set("sort", strings.Join(request.Sort, ","))
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.listEnvelope(ctx, &uri, "results", "pageStart", "pageSize")
+	return &ChangeActivityIterator{it}, err
+}
+
+// GetChangeActivity by ID or number.
+func (rc RestClient) GetChangeActivity(ctx context.Context, id string) (*ChangeActivity, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operatorChangeActivities", id)
+
+	response := &ChangeActivity{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateChangeActivityRequest creates an activity on a change, Change and BriefDescription are required.
+type CreateChangeActivityRequest struct {
+	Change           *Ref               `json:"change"`
+	BriefDescription string             `json:"briefDescription"`
+	ActivityType     ChangeActivityType `json:"activityType,omitempty"`
+	ChangePhase      ChangePhase        `json:"changePhase,omitempty"`
+	Request          string             `json:"request,omitempty"`
+	Action           string             `json:"action,omitempty"`
+	Operator         *Ref               `json:"operator,omitempty"`
+	OperatorGroup    *Ref               `json:"operatorGroup,omitempty"`
+	PlannedStartDate string             `json:"plannedStartDate,omitempty"`
+	PlannedFinalDate string             `json:"plannedFinalDate,omitempty"`
+}
+
+func (rc RestClient) CreateChangeActivity(ctx context.Context, request *CreateChangeActivityRequest) (*ChangeActivity, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operatorChangeActivities")
+
+	response := &Ref{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return rc.GetChangeActivity(ctx, response.ID)
+}
+
+// UpdateChangeActivityRequest patches a change activity, only non-zero fields are sent.
+type UpdateChangeActivityRequest struct {
+	ID               string `json:"-"`
+	BriefDescription string `json:"briefDescription,omitempty"`
+	Request          string `json:"request,omitempty"`
+	Action           string `json:"action,omitempty"`
+	Status           *Ref   `json:"status,omitempty"`
+	Operator         *Ref   `json:"operator,omitempty"`
+	OperatorGroup    *Ref   `json:"operatorGroup,omitempty"`
+	PlannedStartDate string `json:"plannedStartDate,omitempty"`
+	PlannedFinalDate string `json:"plannedFinalDate,omitempty"`
+	Resolved         *bool  `json:"resolved,omitempty"`
+	Skipped          *bool  `json:"skipped,omitempty"`
+}
+
+func (rc RestClient) UpdateChangeActivity(ctx context.Context, request *UpdateChangeActivityRequest) (*ChangeActivity, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operatorChangeActivities", request.ID)
+
+	response := &ChangeActivity{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CompleteChangeActivity resolves an activity, the action is added to the activity when not empty.
+func (rc RestClient) CompleteChangeActivity(ctx context.Context, id string, action string) (*ChangeActivity, error) {
+	return rc.UpdateChangeActivity(ctx, &UpdateChangeActivityRequest{ID: id, Action: action, Resolved: Bool(true)})
+}
+
+// ListChangeApprovals lists the authorization activities of a change.
+func (rc RestClient) ListChangeApprovals(ctx context.Context, changeID string) (*ChangeActivityIterator, error) {
+	return rc.ListChangeActivities(ctx, &ListChangeActivitiesRequest{
+		ChangeID:     changeID,
+		ActivityType: ChangeActivityTypeAuthorization,
+	})
+}
+
+// RespondChangeApprovalRequest approves or rejects an authorization activity.
+type RespondChangeApprovalRequest struct {
+	ID       string
+	Approved bool
+	Reason   string
+}
+
+func (rc RestClient) RespondChangeApproval(ctx context.Context, request *RespondChangeApprovalRequest) (*ChangeActivity, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operatorChangeActivities", request.ID)
+
+	body := struct {
+		Approval struct {
+			Status ChangeApprovalStatus `json:"status"`
+			Reason string               `json:"reason,omitempty"`
+		} `json:"approval"`
+	}{}
+	body.Approval.Status = ChangeApprovalRejected
+	if request.Approved {
+		body.Approval.Status = ChangeApprovalApproved
+	}
+	body.Approval.Reason = request.Reason
+
+	response := &ChangeActivity{}
+	if err := rc.patch(ctx, &uri, body, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// WaitForChangeApproval polls the change until the phase it's in when called is approved or rejected.
+//
+// Returns the final approval status, the context error if it's cancelled first or the first request error. It's
+// an error if the change has no approvable phase, or closes, is canceled or leaves the phase while pending. The
+// interval must be positive.
+func (rc RestClient) WaitForChangeApproval(ctx context.Context, id string, interval time.Duration) (ChangeApprovalStatus, error) {
+	if interval <= 0 {
+		return "", errors.Errorf("wait for change approval: interval %s must be positive", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var phase ChangePhase
+	for {
+		change, err := rc.GetChange(ctx, id)
+		if err != nil {
+			return "", errors.Wrap(err, "wait for change approval")
+		}
+		if phase == "" {
+			phase = change.CurrentPhase
+		}
+
+		switch status := change.PhaseApproval(phase); status {
+		case ChangeApprovalApproved, ChangeApprovalRejected, ChangeApprovalNotApplicable:
+			return status, nil
+		case ChangeApprovalPending:
+			switch {
+			case change.Closed || change.Canceled:
+				return "", errors.Errorf("wait for change approval: change %s closed while %s approval pending", id, phase)
+			case change.CurrentPhase != phase:
+				return "", errors.Errorf("wait for change approval: change %s left %s for %s while approval pending", id, phase, change.CurrentPhase)
+			}
+		default:
+			return "", errors.Errorf("wait for change approval: change %s phase %q has no approval, status %q", id, phase, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWaitForChangeApproval(t *testing.T) {
+	change := func(phase ChangePhase, status ChangeApprovalStatus) Change {
+		c := Change{ID: "c1", CurrentPhase: phase}
+		switch phase {
+		case ChangePhaseRequestForChange:
+			c.Phases.RequestForChange.Approval.Status = status
+		case ChangePhaseProgress:
+			c.Phases.Progress.Approval.Status = status
+		case ChangePhaseEvaluation:
+			c.Phases.Evaluation.Approval.Status = status
+		}
+		return c
+	}
+	closed := change(ChangePhaseProgress, ChangeApprovalPending)
+	closed.Closed = true
+
+	tests := []struct {
+		name    string
+		changes []Change
+		want    ChangeApprovalStatus
+		errors  bool
+	}{
+		{
+			name:    "approved",
+			changes: []Change{change(ChangePhaseRequestForChange, ChangeApprovalPending), change(ChangePhaseRequestForChange, ChangeApprovalApproved)},
+			want:    ChangeApprovalApproved,
+		},
+		{
+			name:    "rejected",
+			changes: []Change{change(ChangePhaseProgress, ChangeApprovalPending), change(ChangePhaseProgress, ChangeApprovalRejected)},
+			want:    ChangeApprovalRejected,
+		},
+		{
+			name:    "not applicable",
+			changes: []Change{change(ChangePhaseEvaluation, ChangeApprovalNotApplicable)},
+			want:    ChangeApprovalNotApplicable,
+		},
+		{
+			name: "approved and moved on",
+			changes: []Change{
+				change(ChangePhaseRequestForChange, ChangeApprovalPending),
+				func() Change {
+					c := change(ChangePhaseProgress, ChangeApprovalPending)
+					c.Phases.RequestForChange.Approval.Status = ChangeApprovalApproved
+					return c
+				}(),
+			},
+			want: ChangeApprovalApproved,
+		},
+		{
+			name:    "phase left",
+			changes: []Change{change(ChangePhaseRequestForChange, ChangeApprovalPending), change(ChangePhaseProgress, ChangeApprovalPending)},
+			errors:  true,
+		},
+		{
+			name:    "closed",
+			changes: []Change{change(ChangePhaseProgress, ChangeApprovalPending), closed},
+			errors:  true,
+		},
+		{
+			name:    "no approvable phase",
+			changes: []Change{{ID: "c1", CurrentPhase: "closed"}},
+			errors:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var mu sync.Mutex
+			polls := 0
+			client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/tas/api/operatorChanges/c1" {
+					w.WriteHeader(http.StatusNotFound)
+					json.NewEncoder(w).Encode(ErrorMessages{})
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				i := polls
+				if i >= len(test.changes) {
+					i = len(test.changes) - 1
+				}
+				polls++
+				json.NewEncoder(w).Encode(test.changes[i])
+			})
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			got, err := client.WaitForChangeApproval(ctx, "c1", time.Millisecond)
+			if test.errors {
+				if err == nil || err == context.DeadlineExceeded {
+					t.Fatalf("got %q, %v, want error", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+
+	if _, err := (RestClient{}).WaitForChangeApproval(context.Background(), "c1", 0); err == nil {
+		t.Error("zero interval got nil error")
+	}
+}
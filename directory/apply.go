@@ -0,0 +1,70 @@
+package directory
+
+import (
+	"context"
+	"time"
+
+	"github.com/techspaceco/topdesk-go"
+)
+
+// Result of applying a single action.
+type Result struct {
+	Action Action
+	Person *topdesk.Person
+	Err    error
+}
+
+// Report of an applied plan, one result per action in plan order.
+type Report struct {
+	Results []Result
+}
+
+// Failed results.
+func (r Report) Failed() []Result {
+	failed := []Result{}
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Apply the plan making at most one request per interval, zero disables rate limiting.
+//
+// A failed action doesn't stop the remaining actions. If the context is cancelled the remaining actions are
+// reported with the context error.
+func (p *Plan) Apply(ctx context.Context, client Client, interval time.Duration) Report {
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	report := Report{Results: make([]Result, 0, len(p.Actions))}
+	for i, action := range p.Actions {
+		if i > 0 && tick != nil {
+			select {
+			case <-ctx.Done():
+			case <-tick:
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			report.Results = append(report.Results, Result{Action: action, Err: err})
+			continue
+		}
+
+		result := Result{Action: action}
+		switch action.Op {
+		case OpCreate:
+			result.Person, result.Err = client.CreatePerson(ctx, action.create)
+		case OpUpdate:
+			result.Person, result.Err = client.UpdatePerson(ctx, action.update)
+		case OpArchive:
+			result.Person, result.Err = client.ArchivePerson(ctx, action.archive)
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report
+}
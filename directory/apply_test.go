@@ -0,0 +1,123 @@
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/techspaceco/topdesk-go"
+)
+
+// fakePeople serves a person list and records create, update and archive requests.
+type fakePeople struct {
+	mu       sync.Mutex
+	people   []topdesk.Person
+	fail     map[string]bool // "METHOD path" to answer with a 500.
+	requests []string
+	bodies   map[string]map[string]interface{}
+}
+
+func (f *fakePeople) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		personsHandler(f.people)(w, r)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	request := r.Method + " " + strings.TrimPrefix(r.URL.Path, "/tas/api")
+	f.requests = append(f.requests, request)
+	body := map[string]interface{}{}
+	json.NewDecoder(r.Body).Decode(&body)
+	f.bodies[request] = body
+
+	if f.fail[request] {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(topdesk.ErrorMessages{{Message: "computer says no"}})
+		return
+	}
+	if r.Method == http.MethodPost {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(topdesk.Person{ID: "new"})
+}
+
+func TestApply(t *testing.T) {
+	fake := &fakePeople{
+		people: []topdesk.Person{
+			{ID: "1", Email: "apple@example.local", FirstName: "Apple"},
+			{ID: "2", Email: "bob@example.local"},
+		},
+		fail:   map[string]bool{"PATCH /persons/id/2/archive": true},
+		bodies: map[string]map[string]interface{}{},
+	}
+	client, server := testClient(t, fake.ServeHTTP)
+	defer server.Close()
+
+	source := NewCSVReader(strings.NewReader("email,firstName\napple@example.local,Ann\ncat@example.local,Cat\n"))
+	config := &Config{Archive: true, ArchiveReason: &topdesk.Ref{ID: "left"}, DefaultBranchID: "b1"}
+	plan, err := Diff(context.Background(), client, source, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := plan.Apply(context.Background(), client, 0)
+
+	want := []string{"PATCH /persons/id/1", "POST /persons", "PATCH /persons/id/2/archive"}
+	if !reflect.DeepEqual(fake.requests, want) {
+		t.Fatalf("got requests %q, want %q", fake.requests, want)
+	}
+	if got := fake.bodies["PATCH /persons/id/1"]["firstName"]; got != "Ann" {
+		t.Errorf("update firstName got %v, want Ann", got)
+	}
+	if got := fake.bodies["POST /persons"]["branch"]; !reflect.DeepEqual(got, map[string]interface{}{"id": "b1"}) {
+		t.Errorf("create branch got %v, want b1", got)
+	}
+	if got := fake.bodies["PATCH /persons/id/2/archive"]["id"]; got != "left" {
+		t.Errorf("archive reason got %v, want left", got)
+	}
+
+	if len(report.Results) != len(plan.Actions) {
+		t.Fatalf("got %d results, want %d", len(report.Results), len(plan.Actions))
+	}
+	for i, result := range report.Results {
+		if result.Action.Op != plan.Actions[i].Op || result.Action.Key != plan.Actions[i].Key {
+			t.Errorf("result %d got %s, want %s", i, result.Action, plan.Actions[i])
+		}
+	}
+	if report.Results[1].Err != nil || report.Results[1].Person == nil || report.Results[1].Person.ID != "new" {
+		t.Errorf("create got %+v, want person new", report.Results[1])
+	}
+
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].Action.Op != OpArchive || failed[0].Err == nil {
+		t.Fatalf("got failed %+v, want the archive", failed)
+	}
+}
+
+func TestApplyCancelled(t *testing.T) {
+	fake := &fakePeople{bodies: map[string]map[string]interface{}{}}
+	client, server := testClient(t, fake.ServeHTTP)
+	defer server.Close()
+
+	plan, err := Diff(context.Background(), client, NewCSVReader(strings.NewReader("email\na@example.local\nb@example.local\n")), &Config{DefaultBranchID: "b1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report := plan.Apply(ctx, client, 0)
+
+	if len(fake.requests) != 0 {
+		t.Errorf("got requests %q after cancel", fake.requests)
+	}
+	if failed := report.Failed(); len(failed) != 2 || failed[0].Err != context.Canceled {
+		t.Errorf("got failed %+v, want both cancelled", failed)
+	}
+}
@@ -0,0 +1,75 @@
+package directory
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CSVReader reads records from a CSV file with a header row.
+//
+// Header names match the Topdesk person field names, case insensitive: email, employeeNumber, firstName,
+// firstInitials, surName, networkLoginName, phoneNumber, mobileNumber, jobTitle and branch (a branch ID).
+// Unknown columns are ignored.
+type CSVReader struct {
+	reader *csv.Reader
+	header map[int]string
+}
+
+func NewCSVReader(r io.Reader) *CSVReader {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	return &CSVReader{reader: reader}
+}
+
+func (c *CSVReader) Read() (*Record, error) {
+	if c.header == nil {
+		columns, err := c.reader.Read()
+		if err != nil {
+			return nil, errors.Wrap(err, "read csv header")
+		}
+
+		c.header = make(map[int]string, len(columns))
+		for i, column := range columns {
+			c.header[i] = strings.ToLower(strings.TrimSpace(column))
+		}
+	}
+
+	row, err := c.reader.Read()
+	if err == io.EOF {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "read csv row")
+	}
+
+	record := &Record{}
+	for i, value := range row {
+		value = strings.TrimSpace(value)
+		switch c.header[i] {
+		case "email":
+			record.Email = value
+		case "employeenumber":
+			record.EmployeeNumber = value
+		case "firstname":
+			record.FirstName = value
+		case "firstinitials":
+			record.FirstInitials = value
+		case "surname":
+			record.Surname = value
+		case "networkloginname":
+			record.NetworkLoginName = value
+		case "phonenumber":
+			record.PhoneNumber = value
+		case "mobilenumber":
+			record.MobileNumber = value
+		case "jobtitle":
+			record.JobTitle = value
+		case "branch":
+			record.BranchID = value
+		}
+	}
+	return record, nil
+}
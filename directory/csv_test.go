@@ -0,0 +1,40 @@
+package directory
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCSVReader(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+		want []Record
+	}{
+		{
+			name: "header case and order",
+			csv:  "SurName,Email,Branch\nArthurton, apple@example.local ,b1\n",
+			want: []Record{{Email: "apple@example.local", Surname: "Arthurton", BranchID: "b1"}},
+		},
+		{
+			name: "unknown columns ignored",
+			csv:  "email,shoeSize\nbob@example.local,11\n",
+			want: []Record{{Email: "bob@example.local"}},
+		},
+		{
+			name: "header only",
+			csv:  "email\n",
+			want: []Record{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := readAll(t, NewCSVReader(strings.NewReader(test.csv)))
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
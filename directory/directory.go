@@ -0,0 +1,78 @@
+// Package directory syncs Topdesk persons with an external source of truth such as an HR export.
+//
+// A Source yields the desired person records, Diff compares them with the persons in Topdesk and produces a Plan
+// of creates, updates and archives. The Plan can be printed for a dry-run or applied.
+//
+//	source := directory.NewCSVReader(file)
+//	plan, err := directory.Diff(ctx, client, source, &directory.Config{Key: directory.KeyEmail})
+//	_ = err // Error handling omitted.
+//
+//	plan.Print(os.Stdout) // Dry-run.
+//	report := plan.Apply(ctx, client, time.Second/5)
+package directory
+
+import (
+	"context"
+	"strings"
+
+	"github.com/techspaceco/topdesk-go"
+)
+
+// Record is the desired state of a person.
+//
+// Empty fields are left alone when updating an existing person.
+type Record struct {
+	Email            string
+	EmployeeNumber   string
+	FirstName        string
+	FirstInitials    string
+	Surname          string
+	NetworkLoginName string
+	PhoneNumber      string
+	MobileNumber     string
+	JobTitle         string
+	BranchID         string
+}
+
+// Source of desired person records.
+//
+// Read returns io.EOF when there are no more records.
+type Source interface {
+	Read() (*Record, error)
+}
+
+// Key identifies the same person in the source and in Topdesk.
+type Key string
+
+const (
+	KeyEmail          Key = "email"
+	KeyEmployeeNumber Key = "employeeNumber"
+)
+
+func (k Key) record(r *Record) string {
+	switch k {
+	case KeyEmployeeNumber:
+		return strings.TrimSpace(r.EmployeeNumber)
+	default:
+		return strings.ToLower(strings.TrimSpace(r.Email))
+	}
+}
+
+func (k Key) person(p *topdesk.Person) string {
+	switch k {
+	case KeyEmployeeNumber:
+		return strings.TrimSpace(p.EmployeeNumber)
+	default:
+		return strings.ToLower(strings.TrimSpace(p.Email))
+	}
+}
+
+// Client is the subset of topdesk.RestClient used to sync persons.
+type Client interface {
+	ListPeople(ctx context.Context, request *topdesk.ListPeopleRequest) (*topdesk.PeopleIterator, error)
+	CreatePerson(ctx context.Context, request *topdesk.CreatePersonRequest) (*topdesk.Person, error)
+	UpdatePerson(ctx context.Context, request *topdesk.UpdatePersonRequest) (*topdesk.Person, error)
+	ArchivePerson(ctx context.Context, request *topdesk.ArchivePersonRequest) (*topdesk.Person, error)
+}
+
+var _ Client = (*topdesk.RestClient)(nil)
@@ -0,0 +1,182 @@
+package directory
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// LDIFAttributes maps LDAP attribute names (lower case) to Record fields.
+var LDIFAttributes = map[string]func(*Record, string){
+	"mail":            func(r *Record, v string) { r.Email = v },
+	"employeenumber":  func(r *Record, v string) { r.EmployeeNumber = v },
+	"givenname":       func(r *Record, v string) { r.FirstName = v },
+	"initials":        func(r *Record, v string) { r.FirstInitials = v },
+	"sn":              func(r *Record, v string) { r.Surname = v },
+	"uid":             func(r *Record, v string) { r.NetworkLoginName = v },
+	"telephonenumber": func(r *Record, v string) { r.PhoneNumber = v },
+	"mobile":          func(r *Record, v string) { r.MobileNumber = v },
+	"title":           func(r *Record, v string) { r.JobTitle = v },
+}
+
+// LDIFObjectClasses are the objectClass values (lower case) read as persons.
+var LDIFObjectClasses = map[string]bool{
+	"person":               true,
+	"organizationalperson": true,
+	"inetorgperson":        true,
+	"user":                 true,
+}
+
+// LDIFReader reads records from LDIF content records (RFC 2849).
+//
+// Attributes are mapped with LDIFAttributes. Change records, entries with an objectClass but none of
+// LDIFObjectClasses (e.g. ou=people) and entries with no mapped attributes are skipped.
+type LDIFReader struct {
+	reader  *bufio.Reader
+	line    string
+	pending bool
+	eof     bool
+}
+
+func NewLDIFReader(r io.Reader) *LDIFReader {
+	return &LDIFReader{reader: bufio.NewReader(r)}
+}
+
+// readLine returns a physical line without the line ending, lines aren't length limited.
+func (l *LDIFReader) readLine() (string, bool, error) {
+	if l.eof {
+		return "", false, nil
+	}
+
+	line, err := l.reader.ReadString('\n')
+	if err == io.EOF {
+		l.eof = true
+		if line == "" {
+			return "", false, nil
+		}
+	} else if err != nil {
+		return "", false, errors.Wrap(err, "read ldif")
+	}
+	return strings.TrimRight(line, "\r\n"), true, nil
+}
+
+// next returns the next logical line, unfolding continuation lines and skipping comments.
+func (l *LDIFReader) next() (string, bool, error) {
+	for {
+		var line string
+		if l.pending {
+			line, l.pending = l.line, false
+		} else {
+			text, ok, err := l.readLine()
+			if err != nil || !ok {
+				return "", false, err
+			}
+			line = text
+		}
+
+		for {
+			text, ok, err := l.readLine()
+			if err != nil {
+				return "", false, err
+			}
+			if !ok {
+				break
+			}
+			if strings.HasPrefix(text, " ") {
+				line += text[1:]
+				continue
+			}
+			l.line, l.pending = text, true
+			break
+		}
+
+		if !strings.HasPrefix(line, "#") {
+			return line, true, nil
+		}
+	}
+}
+
+// ldifEntry accumulates an entry until it's known whether to keep it.
+type ldifEntry struct {
+	record      Record
+	mapped      bool
+	change      bool
+	hasClass    bool
+	personClass bool
+}
+
+func (e *ldifEntry) keep() bool {
+	return e.mapped && !e.change && (!e.hasClass || e.personClass)
+}
+
+func (l *LDIFReader) Read() (*Record, error) {
+	var entry *ldifEntry
+	for {
+		line, ok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok || line == "" {
+			if entry != nil && entry.keep() {
+				return &entry.record, nil
+			}
+			entry = nil
+			if !ok {
+				return nil, io.EOF
+			}
+			continue
+		}
+
+		if entry != nil && entry.change {
+			continue // Change records include "-" separators and aren't read.
+		}
+
+		colon := strings.Index(line, ":")
+		if colon < 0 {
+			return nil, errors.Errorf("read ldif: malformed line %q", line)
+		}
+		name, value := strings.ToLower(line[:colon]), line[colon+1:]
+
+		switch {
+		case strings.HasPrefix(value, ":"):
+			decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value[1:]))
+			if err != nil {
+				return nil, errors.Wrapf(err, "read ldif: decode %s", name)
+			}
+			value = string(decoded)
+		case strings.HasPrefix(value, "<"):
+			continue // URL values aren't supported.
+		default:
+			value = strings.TrimSpace(value)
+		}
+
+		switch name {
+		case "version":
+			continue
+		case "dn":
+			entry = &ldifEntry{}
+			continue
+		}
+
+		if entry == nil {
+			return nil, errors.Errorf("read ldif: %s before dn", name)
+		}
+		switch name {
+		case "changetype":
+			entry.change = true
+		case "objectclass":
+			entry.hasClass = true
+			if LDIFObjectClasses[strings.ToLower(value)] {
+				entry.personClass = true
+			}
+		}
+		if set, ok := LDIFAttributes[name]; ok {
+			set(&entry.record, value)
+			entry.mapped = true
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package directory
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func readAll(t *testing.T, source Source) []Record {
+	t.Helper()
+
+	records := []Record{}
+	for {
+		record, err := source.Read()
+		if err == io.EOF {
+			return records
+		}
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		records = append(records, *record)
+	}
+}
+
+func TestLDIFReader(t *testing.T) {
+	tests := []struct {
+		name string
+		ldif string
+		want []Record
+	}{
+		{
+			name: "entry",
+			ldif: "version: 1\ndn: uid=apple,ou=people,dc=example\nobjectClass: inetOrgPerson\nmail: apple@example.local\ngivenName: Apple\nsn: Arthurton\nuid: apple\n",
+			want: []Record{{Email: "apple@example.local", FirstName: "Apple", Surname: "Arthurton", NetworkLoginName: "apple"}},
+		},
+		{
+			name: "folded line",
+			ldif: "dn: uid=apple,dc=example\nmail: apple@exa\n mple.local\n",
+			want: []Record{{Email: "apple@example.local"}},
+		},
+		{
+			name: "base64 value",
+			ldif: "dn: uid=zoe,dc=example\nmail: zoe@example.local\nsn:: w5Zzdg==\n",
+			want: []Record{{Email: "zoe@example.local", Surname: "Ösv"}},
+		},
+		{
+			name: "comments and crlf",
+			ldif: "# export\r\ndn: uid=apple,dc=example\r\n# note\r\nmail: apple@example.local\r\n\r\n",
+			want: []Record{{Email: "apple@example.local"}},
+		},
+		{
+			name: "url value ignored",
+			ldif: "dn: uid=apple,dc=example\nmail: apple@example.local\ntitle:< file:///tmp/title\n",
+			want: []Record{{Email: "apple@example.local"}},
+		},
+		{
+			name: "change records skipped",
+			ldif: "dn: uid=apple,dc=example\nchangetype: modify\nreplace: mail\nmail: apple@example.local\n-\n\ndn: uid=bob,dc=example\nmail: bob@example.local\n",
+			want: []Record{{Email: "bob@example.local"}},
+		},
+		{
+			name: "organizational unit skipped",
+			ldif: "dn: ou=people,dc=example\nobjectClass: organizationalUnit\nou: people\ntitle: People\n\ndn: uid=bob,ou=people,dc=example\nobjectClass: top\nobjectClass: person\nmail: bob@example.local\n",
+			want: []Record{{Email: "bob@example.local"}},
+		},
+		{
+			name: "unmapped entry skipped",
+			ldif: "dn: dc=example\ndc: example\n\ndn: uid=bob,dc=example\nmail: bob@example.local",
+			want: []Record{{Email: "bob@example.local"}},
+		},
+		{
+			name: "long line",
+			ldif: "dn: uid=apple,dc=example\nmail: apple@example.local\njpegPhoto:: " + strings.Repeat("A", 256*1024) + "\n",
+			want: []Record{{Email: "apple@example.local"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := readAll(t, NewLDIFReader(strings.NewReader(test.ldif)))
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLDIFReaderMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		ldif string
+	}{
+		{name: "missing colon", ldif: "dn: uid=apple,dc=example\nmail apple@example.local\n"},
+		{name: "attribute before dn", ldif: "mail: apple@example.local\n"},
+		{name: "bad base64", ldif: "dn: uid=apple,dc=example\nmail:: !!!\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewLDIFReader(strings.NewReader(test.ldif)).Read(); err == nil || err == io.EOF {
+				t.Errorf("got %v, want error", err)
+			}
+		})
+	}
+}
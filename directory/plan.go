@@ -0,0 +1,236 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/techspaceco/topdesk-go"
+)
+
+// Op is the action taken on a person.
+type Op string
+
+const (
+	OpCreate  Op = "create"
+	OpUpdate  Op = "update"
+	OpArchive Op = "archive"
+)
+
+// Config for Diff.
+type Config struct {
+	// Key matches source records with Topdesk persons, defaults to KeyEmail.
+	Key Key
+
+	// People limits the Topdesk persons considered, e.g. a single branch. Archived persons are always excluded.
+	People *topdesk.ListPeopleRequest
+
+	// Archive persons missing from the source. Requires an ArchiveReason ref.
+	Archive       bool
+	ArchiveReason *topdesk.Ref
+
+	// DefaultBranchID is used to create persons when the record has no BranchID. Diff fails if a person needs
+	// creating without either.
+	DefaultBranchID string
+}
+
+// Action is a single planned change.
+type Action struct {
+	Op      Op
+	Key     string
+	Record  *Record
+	Person  *topdesk.Person
+	Changes []string
+
+	create  *topdesk.CreatePersonRequest
+	update  *topdesk.UpdatePersonRequest
+	archive *topdesk.ArchivePersonRequest
+}
+
+func (a Action) String() string {
+	switch a.Op {
+	case OpUpdate:
+		return fmt.Sprintf("%s %s (%s)", a.Op, a.Key, strings.Join(a.Changes, ", "))
+	default:
+		return fmt.Sprintf("%s %s", a.Op, a.Key)
+	}
+}
+
+// Plan of changes to bring Topdesk in line with the source.
+type Plan struct {
+	Actions []Action
+}
+
+// Print the plan, one action per line.
+func (p *Plan) Print(w io.Writer) error {
+	for _, action := range p.Actions {
+		if _, err := fmt.Fprintln(w, action); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff the source against Topdesk persons.
+func Diff(ctx context.Context, client Client, source Source, config *Config) (*Plan, error) {
+	if config == nil {
+		config = &Config{}
+	}
+	key := config.Key
+	if key == "" {
+		key = KeyEmail
+	}
+	if config.Archive && config.ArchiveReason == nil {
+		return nil, errors.New("diff: archive requires an archive reason")
+	}
+
+	request := topdesk.ListPeopleRequest{}
+	if config.People != nil {
+		request = *config.People
+	}
+	request.Archived = topdesk.Bool(false)
+
+	people, err := client.ListPeople(ctx, &request)
+	if err != nil {
+		return nil, errors.Wrap(err, "diff: list people")
+	}
+
+	existing := map[string]*topdesk.Person{}
+	order := []string{}
+	for people.Next() {
+		person, err := people.Person()
+		if err != nil {
+			return nil, errors.Wrap(err, "diff: decode person")
+		}
+		k := key.person(person)
+		if k == "" {
+			continue // Not managed by the source.
+		}
+		if other, ok := existing[k]; ok {
+			return nil, errors.Errorf("diff: persons %s and %s share %s %s", other.ID, person.ID, key, k)
+		}
+		order = append(order, k)
+		existing[k] = person
+	}
+	if err := people.Err(); err != nil {
+		return nil, errors.Wrap(err, "diff: list people")
+	}
+
+	plan := &Plan{}
+	seen := map[string]bool{}
+	for {
+		record, err := source.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "diff: read source")
+		}
+
+		k := key.record(record)
+		if k == "" {
+			return nil, errors.Errorf("diff: record missing %s", key)
+		}
+		if seen[k] {
+			return nil, errors.Errorf("diff: duplicate %s %s", key, k)
+		}
+		seen[k] = true
+
+		person, ok := existing[k]
+		if !ok {
+			if record.BranchID == "" && config.DefaultBranchID == "" {
+				return nil, errors.Errorf("diff: create %s missing branch, set one in the source or Config.DefaultBranchID", k)
+			}
+			plan.Actions = append(plan.Actions, Action{
+				Op:     OpCreate,
+				Key:    k,
+				Record: record,
+				create: createRequest(record, config.DefaultBranchID),
+			})
+			continue
+		}
+
+		if update, changes := updateRequest(record, person); len(changes) > 0 {
+			plan.Actions = append(plan.Actions, Action{
+				Op:      OpUpdate,
+				Key:     k,
+				Record:  record,
+				Person:  person,
+				Changes: changes,
+				update:  update,
+			})
+		}
+	}
+
+	if config.Archive {
+		for _, k := range order {
+			if seen[k] {
+				continue
+			}
+			person := existing[k]
+			plan.Actions = append(plan.Actions, Action{
+				Op:      OpArchive,
+				Key:     k,
+				Person:  person,
+				archive: &topdesk.ArchivePersonRequest{ID: person.ID, Reason: config.ArchiveReason},
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+func createRequest(record *Record, defaultBranchID string) *topdesk.CreatePersonRequest {
+	branchID := record.BranchID
+	if branchID == "" {
+		branchID = defaultBranchID
+	}
+
+	return &topdesk.CreatePersonRequest{
+		Surname:          record.Surname,
+		FirstName:        record.FirstName,
+		FirstInitials:    record.FirstInitials,
+		EmployeeNumber:   record.EmployeeNumber,
+		NetworkLoginName: record.NetworkLoginName,
+		Branch:           &topdesk.Ref{ID: branchID},
+		PhoneNumber:      record.PhoneNumber,
+		MobileNumber:     record.MobileNumber,
+		Email:            record.Email,
+		JobTitle:         record.JobTitle,
+	}
+}
+
+func updateRequest(record *Record, person *topdesk.Person) (*topdesk.UpdatePersonRequest, []string) {
+	request := &topdesk.UpdatePersonRequest{ID: person.ID}
+	changes := []string{}
+
+	diff := func(name, want, have string, set *string) {
+		if want == "" || want == have || (name == "email" && strings.EqualFold(want, have)) {
+			return
+		}
+		*set = want
+		changes = append(changes, fmt.Sprintf("%s %q -> %q", name, have, want))
+	}
+	diff("email", record.Email, person.Email, &request.Email)
+	diff("employeeNumber", record.EmployeeNumber, person.EmployeeNumber, &request.EmployeeNumber)
+	diff("firstName", record.FirstName, person.FirstName, &request.FirstName)
+	diff("firstInitials", record.FirstInitials, person.FirstInitials, &request.FirstInitials)
+	diff("surName", record.Surname, person.Surname, &request.Surname)
+	diff("networkLoginName", record.NetworkLoginName, person.NetworkLoginName, &request.NetworkLoginName)
+	diff("phoneNumber", record.PhoneNumber, person.PhoneNumber, &request.PhoneNumber)
+	diff("mobileNumber", record.MobileNumber, person.MobileNumber, &request.MobileNumber)
+	diff("jobTitle", record.JobTitle, person.JobTitle, &request.JobTitle)
+
+	branchID := ""
+	if person.Branch != nil {
+		branchID = person.Branch.ID
+	}
+	if record.BranchID != "" && record.BranchID != branchID {
+		request.Branch = &topdesk.Ref{ID: record.BranchID}
+		changes = append(changes, fmt.Sprintf("branch %q -> %q", branchID, record.BranchID))
+	}
+
+	return request, changes
+}
@@ -0,0 +1,185 @@
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/techspaceco/topdesk-go"
+)
+
+// testClient returns a client for a fake Topdesk, close the server when done.
+func testClient(t *testing.T, handler http.HandlerFunc) (*topdesk.RestClient, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	client, err := topdesk.NewRestClient(context.Background(), server.URL+"/tas/api", "user:token")
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	return client, server
+}
+
+func personsHandler(people []topdesk.Person) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tas/api/persons" || r.URL.Query().Get("start") != "0" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		json.NewEncoder(w).Encode(people)
+	}
+}
+
+func ops(plan *Plan) []string {
+	got := []string{}
+	for _, action := range plan.Actions {
+		got = append(got, string(action.Op)+" "+action.Key)
+	}
+	return got
+}
+
+func TestDiff(t *testing.T) {
+	people := []topdesk.Person{
+		{ID: "1", Email: "Apple@example.local", FirstName: "Apple", EmployeeNumber: "100"},
+		{ID: "2", Email: "bob@example.local", FirstName: "Bob", EmployeeNumber: "200"},
+		{ID: "3", Email: "", FirstName: "Unmanaged"},
+	}
+	reason := &topdesk.Ref{ID: "left"}
+
+	tests := []struct {
+		name   string
+		csv    string
+		config *Config
+		want   []string
+		err    string
+	}{
+		{
+			name: "create and update",
+			csv:  "email,firstName\napple@example.local,Apple\nbob@example.local,Robert\ncat@example.local,Cat\n",
+			want: []string{"update bob@example.local", "create cat@example.local"},
+		},
+		{
+			name:   "archive missing",
+			csv:    "email\napple@example.local\n",
+			config: &Config{Archive: true, ArchiveReason: reason},
+			want:   []string{"archive bob@example.local"},
+		},
+		{
+			name: "missing left alone without archive",
+			csv:  "email\napple@example.local\n",
+			want: []string{},
+		},
+		{
+			name:   "employee number key",
+			csv:    "employeeNumber,email\n100,apple@new.local\n",
+			config: &Config{Key: KeyEmployeeNumber, Archive: true, ArchiveReason: reason},
+			want:   []string{"update 100", "archive 200"},
+		},
+		{
+			name:   "archive requires reason",
+			csv:    "email\n",
+			config: &Config{Archive: true},
+			err:    "archive requires an archive reason",
+		},
+		{
+			name: "duplicate key",
+			csv:  "email\ncat@example.local\nCAT@example.local\n",
+			err:  "duplicate email cat@example.local",
+		},
+		{
+			name:   "create missing branch",
+			csv:    "email\ncat@example.local\n",
+			config: &Config{},
+			err:    "create cat@example.local missing branch",
+		},
+		{
+			name:   "create record branch",
+			csv:    "email,branch\ncat@example.local,b2\n",
+			config: &Config{},
+			want:   []string{"create cat@example.local"},
+		},
+		{
+			name: "missing key",
+			csv:  "email,firstName\n,Nobody\n",
+			err:  "record missing email",
+		},
+	}
+
+	client, server := testClient(t, personsHandler(people))
+	defer server.Close()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := test.config
+			if config == nil {
+				config = &Config{DefaultBranchID: "b1"}
+			}
+			plan, err := Diff(context.Background(), client, NewCSVReader(strings.NewReader(test.csv)), config)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("got error %v, want %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ops(plan); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDiffArchiveRequest(t *testing.T) {
+	client, server := testClient(t, personsHandler([]topdesk.Person{{ID: "2", Email: "bob@example.local"}}))
+	defer server.Close()
+	reason := &topdesk.Ref{ID: "left"}
+
+	plan, err := Diff(context.Background(), client, NewCSVReader(strings.NewReader("email\n")), &Config{Archive: true, ArchiveReason: reason})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Actions) != 1 {
+		t.Fatalf("got %d actions, want 1", len(plan.Actions))
+	}
+	want := &topdesk.ArchivePersonRequest{ID: "2", Reason: reason}
+	if got := plan.Actions[0].archive; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDiffDuplicatePersons(t *testing.T) {
+	client, server := testClient(t, personsHandler([]topdesk.Person{
+		{ID: "1", Email: "bob@example.local"},
+		{ID: "2", Email: "Bob@example.local"},
+	}))
+	defer server.Close()
+
+	_, err := Diff(context.Background(), client, NewCSVReader(strings.NewReader("email\n")), nil)
+	if err == nil || !strings.Contains(err.Error(), "persons 1 and 2 share email bob@example.local") {
+		t.Fatalf("got error %v, want duplicate persons", err)
+	}
+}
+
+func TestDiffListError(t *testing.T) {
+	client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start") == "0" {
+			w.WriteHeader(http.StatusPartialContent)
+			json.NewEncoder(w).Encode([]topdesk.Person{{ID: "1", Email: "apple@example.local"}})
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(topdesk.ErrorMessages{})
+	})
+	defer server.Close()
+
+	source := NewCSVReader(strings.NewReader("email\napple@example.local\n"))
+	if _, err := Diff(context.Background(), client, source, &Config{Archive: true, ArchiveReason: &topdesk.Ref{ID: "left"}}); err == nil {
+		t.Fatal("got nil error, want list people error")
+	}
+}
@@ -3,6 +3,7 @@ package topdesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"path"
 )
@@ -215,11 +216,14 @@ type Incident struct {
 	PartialIncidents  []struct {
 		Link string `json:"link"`
 	} `json:"partialIncidents"`
-	ExternalLinks []struct {
-		ID   string `json:"id"`
-		Type string `json:"type"`
-		Date string `json:"date"`
-	} `json:"externalLinks"`
+	ExternalLinks []ExternalLink `json:"externalLinks"`
+}
+
+// ExternalLink points an incident at a record in another system.
+type ExternalLink struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Date string `json:"date,omitempty"`
 }
 
 func (i Incident) Ref() *Ref {
@@ -236,7 +240,9 @@ func (i Incident) RelativeURL() *url.URL {
 }
 
 type ListIncidentsRequest struct {
-	ExternalNumber []string
+	ExternalNumber   []string
+	ExternalLinkID   string
+	ExternalLinkType string
 }
 
 func (rc RestClient) ListIncidents(ctx context.Context, request *ListIncidentsRequest) (*IncidentIterator, error) {
@@ -248,6 +254,12 @@ func (rc RestClient) ListIncidents(ctx context.Context, request *ListIncidentsRe
 		for _, no := range request.ExternalNumber {
 			query.Add("external_number", no)
 		}
+		if request.ExternalLinkID != "" {
+			query.Set("external_link_id", request.ExternalLinkID)
+		}
+		if request.ExternalLinkType != "" {
+			query.Set("external_link_type", request.ExternalLinkType)
+		}
 
 		uri.RawQuery = query.Encode()
 	}
@@ -288,6 +300,7 @@ type CreateIncidentRequest struct {
 	Action                   string         `json:"action,omitempty"`
 	ActionInvisibleForCaller bool           `json:"actionInvisibleForCaller,omitempty"`
 	ExternalNumber           string         `json:"externalNumber,omitempty"`
+	Supplier                 *Ref           `json:"supplier,omitempty"`
 }
 
 func (rc RestClient) CreateIncident(ctx context.Context, request *CreateIncidentRequest) (*Incident, error) {
@@ -310,6 +323,7 @@ type UpdateIncidentRequest struct {
 	Action                   string         `json:"action,omitempty"`
 	ActionInvisibleForCaller bool           `json:"actionInvisibleForCaller,omitempty"`
 	ExternalNumber           string         `json:"externalNumber,omitempty"`
+	Supplier                 *Ref           `json:"supplier,omitempty"`
 }
 
 func (rc RestClient) UpdateIncident(ctx context.Context, request *UpdateIncidentRequest) (*Incident, error) {
@@ -323,3 +337,48 @@ func (rc RestClient) UpdateIncident(ctx context.Context, request *UpdateIncident
 
 	return response, nil
 }
+
+func (rc RestClient) ListIncidentExternalLinks(ctx context.Context, id string) ([]ExternalLink, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "incidents", "id", id, "externalLinks")
+
+	response := []ExternalLink{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type AddIncidentExternalLinkRequest struct {
+	IncidentID string `json:"-"`
+	ExternalLink
+}
+
+func (rc RestClient) AddIncidentExternalLink(ctx context.Context, request *AddIncidentExternalLinkRequest) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "incidents", "id", request.IncidentID, "externalLinks")
+
+	return rc.create(ctx, &uri, request, &json.RawMessage{})
+}
+
+func (rc RestClient) RemoveIncidentExternalLink(ctx context.Context, incidentID string, linkID string) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "incidents", "id", incidentID, "externalLinks", linkID)
+
+	return rc.delete(ctx, &uri, nil)
+}
+
+// FindIncidentByExternalLink returns the first incident linked to the external record.
+func (rc RestClient) FindIncidentByExternalLink(ctx context.Context, linkType string, linkID string) (*Incident, error) {
+	incidents, err := rc.ListIncidents(ctx, &ListIncidentsRequest{ExternalLinkID: linkID, ExternalLinkType: linkType})
+	if err != nil {
+		return nil, err
+	}
+	if !incidents.Next() {
+		if err := incidents.Err(); err != nil {
+			return nil, err
+		}
+		return nil, NotFoundError{Resource: "incident", Query: fmt.Sprintf("external link %s/%s", linkType, linkID)}
+	}
+	return incidents.Incident()
+}
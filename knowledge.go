@@ -0,0 +1,245 @@
+package topdesk
+
+// Knowledge base.
+//
+// https://developers.topdesk.com/explorer/?page=knowledge-base
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type KnowledgeItemIterator struct {
+	*ListIterator
+}
+
+// KnowledgeItem decodes the listed item, only the requested fields are set when ListKnowledgeItemsRequest.Fields is used.
+func (i KnowledgeItemIterator) KnowledgeItem() (*KnowledgeItem, error) {
+	response := &KnowledgeItem{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// KnowledgeItemContent in a single language.
+type KnowledgeItemContent struct {
+	Title                string `json:"title,omitempty"`
+	Description          string `json:"description,omitempty"`
+	Content              string `json:"content,omitempty"`
+	CommentsForOperators string `json:"commentsForOperators,omitempty"`
+	Keywords             string `json:"keywords,omitempty"`
+}
+
+type KnowledgeItemTranslation struct {
+	Language         string               `json:"language"`
+	Content          KnowledgeItemContent `json:"content"`
+	CreationDate     string               `json:"creationDate,omitempty"`
+	ModificationDate string               `json:"modificationDate,omitempty"`
+}
+
+// KnowledgeItemVisibility for operators and the self-service portal (SSP).
+type KnowledgeItemVisibility struct {
+	SSPVisibility                        bool   `json:"sspVisibility"`
+	SSPVisibleFrom                       string `json:"sspVisibleFrom,omitempty"`
+	SSPVisibleUntil                      string `json:"sspVisibleUntil,omitempty"`
+	SSPVisibilityFilteredOnBranches      bool   `json:"sspVisibilityFilteredOnBranches"`
+	OperatorVisibilityFilteredOnBranches bool   `json:"operatorVisibilityFilteredOnBranches"`
+	OpenKnowledgeItem                    bool   `json:"openKnowledgeItem"`
+}
+
+type KnowledgeItem struct {
+	ID          string                   `json:"id"`
+	Number      string                   `json:"number"`
+	Parent      *Ref                     `json:"parent"`
+	Translation KnowledgeItemTranslation `json:"translation"`
+	Visibility  KnowledgeItemVisibility  `json:"visibility"`
+	Status      struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"status"`
+	Manager struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"manager"`
+	Archived         bool   `json:"archived"`
+	CreationDate     string `json:"creationDate"`
+	ModificationDate string `json:"modificationDate"`
+}
+
+func (k KnowledgeItem) Ref() *Ref {
+	return &Ref{ID: k.ID}
+}
+
+// ListKnowledgeItemsRequest filters knowledge items, empty fields are ignored.
+//
+// Language selects the translation returned for each item. Query is a FIQL expression.
+type ListKnowledgeItemsRequest struct {
+	Language string
+	StatusID string
+	Archived *bool
+	Query    string
+	Fields   []string
+}
+
+func (rc RestClient) ListKnowledgeItems(ctx context.Context, request *ListKnowledgeItemsRequest) (*KnowledgeItemIterator, error) {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems")
+
+	if request != nil {
+		query := uri.Query()
+		if request.Language != "" {
+			query.Set("language", request.Language)
+		}
+		filter := fiql{}.eq("status.id", request.StatusID).and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		if request.Archived != nil {
+			query.Set("archived", strconv.FormatBool(*request.Archived))
+		}
+		if len(request.Fields) > 0 {
+			query.Set("fields", strings.Join(request.Fields, ","))
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.listEnvelope(ctx, &uri, "item", "start", "page_size")
+	return &KnowledgeItemIterator{it}, err
+}
+
+// SearchKnowledgeItems matches every word against the title and keywords of SSP visible items.
+func (rc RestClient) SearchKnowledgeItems(ctx context.Context, text string, language string) (*KnowledgeItemIterator, error) {
+	filter := fiql{}.and("visibility.sspVisibility==true")
+	for _, word := range strings.Fields(text) {
+		word = searchWord(word)
+		if word == "" {
+			continue
+		}
+		filter = filter.and("translation.content.title==*" + word + "*,translation.content.keywords==*" + word + "*")
+	}
+
+	return rc.ListKnowledgeItems(ctx, &ListKnowledgeItemsRequest{Language: language, Query: filter.String()})
+}
+
+// searchWord strips FIQL reserved runes from anywhere in the word, they can't be escaped inside a wildcard match.
+func searchWord(word string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`"'();,=!<>*\~`, r) {
+			return -1
+		}
+		return r
+	}, word)
+}
+
+// GetKnowledgeItem by ID or number, an empty language returns the default translation.
+func (rc RestClient) GetKnowledgeItem(ctx context.Context, id string, language string) (*KnowledgeItem, error) {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems", id)
+	if language != "" {
+		query := uri.Query()
+		query.Set("language", language)
+		uri.RawQuery = query.Encode()
+	}
+
+	response := &KnowledgeItem{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateKnowledgeItemRequest creates a knowledge item with its first translation.
+type CreateKnowledgeItemRequest struct {
+	Parent      *Ref                     `json:"parent,omitempty"`
+	Translation KnowledgeItemTranslation `json:"translation"`
+	Visibility  *KnowledgeItemVisibility `json:"visibility,omitempty"`
+	Status      *Ref                     `json:"status,omitempty"`
+	Manager     *Ref                     `json:"manager,omitempty"`
+}
+
+func (rc RestClient) CreateKnowledgeItem(ctx context.Context, request *CreateKnowledgeItemRequest) (*KnowledgeItem, error) {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems")
+
+	response := &Ref{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return rc.GetKnowledgeItem(ctx, response.ID, request.Translation.Language)
+}
+
+// UpdateKnowledgeItemRequest patches a knowledge item, only non-zero fields are sent.
+//
+// Translations are updated with UpdateKnowledgeItemTranslation.
+type UpdateKnowledgeItemRequest struct {
+	ID         string                   `json:"-"`
+	Parent     *Ref                     `json:"parent,omitempty"`
+	Visibility *KnowledgeItemVisibility `json:"visibility,omitempty"`
+	Status     *Ref                     `json:"status,omitempty"`
+	Manager    *Ref                     `json:"manager,omitempty"`
+}
+
+// UpdateKnowledgeItem returns the updated item with its default translation.
+func (rc RestClient) UpdateKnowledgeItem(ctx context.Context, request *UpdateKnowledgeItemRequest) (*KnowledgeItem, error) {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems", request.ID)
+
+	if err := rc.patch(ctx, &uri, request, &json.RawMessage{}); err != nil {
+		return nil, err
+	}
+
+	return rc.GetKnowledgeItem(ctx, request.ID, "")
+}
+
+func (rc RestClient) ListKnowledgeItemTranslations(ctx context.Context, id string) ([]KnowledgeItemTranslation, error) {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems", id, "translations")
+
+	response := []KnowledgeItemTranslation{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CreateKnowledgeItemTranslation adds a language to a knowledge item.
+func (rc RestClient) CreateKnowledgeItemTranslation(ctx context.Context, id string, translation *KnowledgeItemTranslation) error {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems", id, "translations")
+
+	return rc.create(ctx, &uri, translation, &json.RawMessage{})
+}
+
+// UpdateKnowledgeItemTranslation patches the translation for translation.Language, empty content is left alone.
+func (rc RestClient) UpdateKnowledgeItemTranslation(ctx context.Context, id string, translation *KnowledgeItemTranslation) error {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems", id, "translations", translation.Language)
+
+	return rc.patch(ctx, &uri, translation.Content, &json.RawMessage{})
+}
+
+type KnowledgeItemAttachment struct {
+	ID       string `json:"id"`
+	FileName string `json:"fileName"`
+	Size     int64  `json:"size"`
+}
+
+func (rc RestClient) ListKnowledgeItemAttachments(ctx context.Context, id string) ([]KnowledgeItemAttachment, error) {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems", id, "attachments")
+
+	response := []KnowledgeItemAttachment{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DownloadKnowledgeItemAttachment writes the attachment content to w.
+func (rc RestClient) DownloadKnowledgeItemAttachment(ctx context.Context, id string, attachmentID string, w io.Writer) error {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems", id, "attachments", attachmentID, "download")
+
+	return rc.download(ctx, &uri, w)
+}
+
+// LinkKnowledgeItemIncident records that the knowledge item was used for the incident.
+func (rc RestClient) LinkKnowledgeItemIncident(ctx context.Context, id string, incidentID string) error {
+	uri := rc.servicesURL("knowledge-base-v1", "knowledgeItems", id, "incidents")
+
+	return rc.create(ctx, &uri, &Ref{ID: incidentID}, &json.RawMessage{})
+}
@@ -0,0 +1,61 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSearchWord(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{word: "printer", want: "printer"},
+		{word: `"printer"`, want: "printer"},
+		{word: "a,b;c", want: "abc"},
+		{word: "x==y)", want: "xy"},
+		{word: `wi*ld\card`, want: "wildcard"},
+		{word: "(),;", want: ""},
+		{word: "café", want: "café"},
+	}
+
+	for _, test := range tests {
+		if got := searchWord(test.word); got != test.want {
+			t.Errorf("searchWord(%q) got %q, want %q", test.word, got, test.want)
+		}
+	}
+}
+
+func TestUpdateKnowledgeItem(t *testing.T) {
+	patched := false
+	client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/knowledge-base-v1/knowledgeItems/k1" {
+			t.Errorf("got path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			patched = true
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			if !patched {
+				t.Error("got item before patch")
+			}
+			json.NewEncoder(w).Encode(KnowledgeItem{ID: "k1", Number: "KI 0001"})
+		default:
+			t.Errorf("got method %s", r.Method)
+		}
+	})
+	defer server.Close()
+
+	item, err := client.UpdateKnowledgeItem(context.Background(), &UpdateKnowledgeItemRequest{ID: "k1", Status: &Ref{ID: "s1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Number != "KI 0001" {
+		t.Errorf("got item %+v", item)
+	}
+}
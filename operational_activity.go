@@ -0,0 +1,187 @@
+package topdesk
+
+// Operations management.
+//
+// https://developers.topdesk.com/explorer/?page=operations-management
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+)
+
+type OperationalActivityIterator struct {
+	*ListIterator
+}
+
+// OperationalActivity decodes the listed activity.
+func (i OperationalActivityIterator) OperationalActivity() (*OperationalActivity, error) {
+	response := &OperationalActivity{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// OperationalActivity is a planned or recurring maintenance task.
+type OperationalActivity struct {
+	ID               string `json:"id"`
+	Number           string `json:"number"`
+	BriefDescription string `json:"briefDescription"`
+	Action           string `json:"action"`
+	Type             struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"type"`
+	Status struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"status"`
+	Schedule struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"schedule"`
+	Operator struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"operator"`
+	OperatorGroup struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"operatorGroup"`
+	Branch struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"branch"`
+	Location struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"location"`
+	LinkedObjects []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"linkedObjects"`
+	PlannedStartDate string `json:"plannedStartDate"`
+	PlannedEndDate   string `json:"plannedEndDate"`
+	Resolved         bool   `json:"resolved"`
+	ResolvedDate     string `json:"resolvedDate"`
+	Skipped          bool   `json:"skipped"`
+	CreationDate     string `json:"creationDate"`
+	ModificationDate string `json:"modificationDate"`
+}
+
+func (a OperationalActivity) Ref() *Ref {
+	return &Ref{ID: a.ID}
+}
+
+// ListOperationalActivitiesRequest filters operational activities, empty fields are ignored.
+//
+// Query is a FIQL expression and'ed with the other filters.
+type ListOperationalActivitiesRequest struct {
+	ScheduleID      string
+	OperatorGroupID string
+	OperatorID      string
+	StatusID        string
+	Resolved        *bool
+	Query           string
+	Sort            []string
+}
+
+func (rc RestClient) ListOperationalActivities(ctx context.Context, request *ListOperationalActivitiesRequest) (*OperationalActivityIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operationalActivities")
+
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.
+			eq("schedule.id", request.ScheduleID).
+			eq("operatorGroup.id", request.OperatorGroupID).
+			eq("operator.id", request.OperatorID).
+			eq("status.id", request.StatusID)
+		if request.Resolved != nil {
+			filter = filter.eq("resolved", strconv.FormatBool(*request.Resolved))
+		}
+		filter = filter.and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		if len(request.Sort) > 0 {
+			query.Set("sort", strings.Join(request.Sort, ","))
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.listEnvelope(ctx, &uri, "results", "pageStart", "pageSize")
+	return &OperationalActivityIterator{it}, err
+}
+
+// GetOperationalActivity by ID or number.
+func (rc RestClient) GetOperationalActivity(ctx context.Context, id string) (*OperationalActivity, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operationalActivities", id)
+
+	response := &OperationalActivity{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateOperationalActivityRequest creates an operational activity, BriefDescription is required.
+type CreateOperationalActivityRequest struct {
+	BriefDescription string `json:"briefDescription"`
+	Action           string `json:"action,omitempty"`
+	Type             *Ref   `json:"type,omitempty"`
+	Schedule         *Ref   `json:"schedule,omitempty"`
+	Operator         *Ref   `json:"operator,omitempty"`
+	OperatorGroup    *Ref   `json:"operatorGroup,omitempty"`
+	Branch           *Ref   `json:"branch,omitempty"`
+	Location         *Ref   `json:"location,omitempty"`
+	PlannedStartDate string `json:"plannedStartDate,omitempty"`
+	PlannedEndDate   string `json:"plannedEndDate,omitempty"`
+}
+
+func (rc RestClient) CreateOperationalActivity(ctx context.Context, request *CreateOperationalActivityRequest) (*OperationalActivity, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operationalActivities")
+
+	response := &OperationalActivity{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateOperationalActivityRequest patches an operational activity, only non-zero fields are sent.
+//
+// Action is added to the activity log rather than replacing it.
+type UpdateOperationalActivityRequest struct {
+	ID               string `json:"-"`
+	BriefDescription string `json:"briefDescription,omitempty"`
+	Action           string `json:"action,omitempty"`
+	Status           *Ref   `json:"status,omitempty"`
+	Operator         *Ref   `json:"operator,omitempty"`
+	OperatorGroup    *Ref   `json:"operatorGroup,omitempty"`
+	PlannedStartDate string `json:"plannedStartDate,omitempty"`
+	PlannedEndDate   string `json:"plannedEndDate,omitempty"`
+	Resolved         *bool  `json:"resolved,omitempty"`
+	Skipped          *bool  `json:"skipped,omitempty"`
+}
+
+func (rc RestClient) UpdateOperationalActivity(ctx context.Context, request *UpdateOperationalActivityRequest) (*OperationalActivity, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operationalActivities", request.ID)
+
+	response := &OperationalActivity{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CompleteOperationalActivity marks the activity done, notes are added to the action when not empty.
+func (rc RestClient) CompleteOperationalActivity(ctx context.Context, id string, notes string) (*OperationalActivity, error) {
+	return rc.UpdateOperationalActivity(ctx, &UpdateOperationalActivityRequest{ID: id, Action: notes, Resolved: Bool(true)})
+}
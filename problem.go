@@ -0,0 +1,252 @@
+package topdesk
+
+// Problem management.
+//
+// https://developers.topdesk.com/explorer/?page=problem
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+)
+
+type ProblemIterator struct {
+	*ListIterator
+}
+
+// Problem decodes the listed problem, only the requested fields are set when ListProblemsRequest.Fields is used.
+func (i ProblemIterator) Problem() (*Problem, error) {
+	response := &Problem{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ProblemStatus, a problem becomes a known error once the cause is found.
+type ProblemStatus string
+
+const (
+	ProblemStatusProblem    ProblemStatus = "problem"
+	ProblemStatusKnownError ProblemStatus = "knownError"
+)
+
+type Problem struct {
+	ID               string        `json:"id"`
+	Number           string        `json:"number"`
+	Status           ProblemStatus `json:"status"`
+	BriefDescription string        `json:"briefDescription"`
+	Request          string        `json:"request"`
+	Action           string        `json:"action"`
+	Category         struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"category"`
+	Subcategory struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"subcategory"`
+	ProblemType struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"problemType"`
+	Cause struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"cause"`
+	Impact struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"impact"`
+	Urgency struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"urgency"`
+	Priority struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"priority"`
+	Operator struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"operator"`
+	OperatorGroup struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"operatorGroup"`
+	ProcessingStatus struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"processingStatus"`
+	TargetDate       string `json:"targetDate"`
+	Completed        bool   `json:"completed"`
+	CompletedDate    string `json:"completedDate"`
+	Closed           bool   `json:"closed"`
+	ClosedDate       string `json:"closedDate"`
+	CreationDate     string `json:"creationDate"`
+	ModificationDate string `json:"modificationDate"`
+}
+
+func (p Problem) Ref() *Ref {
+	return &Ref{ID: p.ID}
+}
+
+func (p Problem) RelativeURL() *url.URL {
+	uri, _ := url.Parse("/tas/secure/contained/probleem")
+	query := url.Values{}
+	query.Set("action", "show")
+	query.Set("unid", p.ID)
+	uri.RawQuery = query.Encode()
+	return uri
+}
+
+// ListProblemsRequest filters problems, empty fields are ignored.
+//
+// Query is a FIQL expression and'ed with the other filters.
+type ListProblemsRequest struct {
+	Status          ProblemStatus
+	CategoryID      string
+	OperatorID      string
+	OperatorGroupID string
+	Query           string
+	Sort            []string
+	Fields          []string
+}
+
+func (rc RestClient) ListProblems(ctx context.Context, request *ListProblemsRequest) (*ProblemIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "problems")
+
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.
+			eq("status", string(request.Status)).
+			eq("category.id", request.CategoryID).
+			eq("operator.id", request.OperatorID).
+			eq("operatorGroup.id", request.OperatorGroupID).
+			and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		if len(request.Sort) > 0 {
+			query.Set("sort", strings.Join(request.Sort, ","))
+		}
+		if len(request.Fields) > 0 {
+			query.Set("fields", strings.Join(request.Fields, ","))
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.list(ctx, &uri)
+	return &ProblemIterator{it}, err
+}
+
+// ListKnownErrors lists problems with a known error status.
+func (rc RestClient) ListKnownErrors(ctx context.Context) (*ProblemIterator, error) {
+	return rc.ListProblems(ctx, &ListProblemsRequest{Status: ProblemStatusKnownError})
+}
+
+// GetProblem by ID or number.
+func (rc RestClient) GetProblem(ctx context.Context, id string) (*Problem, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "problems", id)
+
+	response := &Problem{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateProblemRequest creates a problem, BriefDescription is required.
+type CreateProblemRequest struct {
+	Status           ProblemStatus `json:"status,omitempty"`
+	BriefDescription string        `json:"briefDescription"`
+	Request          string        `json:"request,omitempty"`
+	Action           string        `json:"action,omitempty"`
+	Category         *Ref          `json:"category,omitempty"`
+	Subcategory      *Ref          `json:"subcategory,omitempty"`
+	ProblemType      *Ref          `json:"problemType,omitempty"`
+	Impact           *Ref          `json:"impact,omitempty"`
+	Urgency          *Ref          `json:"urgency,omitempty"`
+	Priority         *Ref          `json:"priority,omitempty"`
+	Operator         *Ref          `json:"operator,omitempty"`
+	OperatorGroup    *Ref          `json:"operatorGroup,omitempty"`
+	TargetDate       string        `json:"targetDate,omitempty"`
+}
+
+func (rc RestClient) CreateProblem(ctx context.Context, request *CreateProblemRequest) (*Problem, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "problems")
+
+	response := &Ref{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return rc.GetProblem(ctx, response.ID)
+}
+
+// UpdateProblemRequest patches a problem, only non-zero fields are sent.
+//
+// Set Status to ProblemStatusKnownError and Cause once the root cause is found.
+type UpdateProblemRequest struct {
+	ID               string        `json:"-"`
+	Status           ProblemStatus `json:"status,omitempty"`
+	BriefDescription string        `json:"briefDescription,omitempty"`
+	Request          string        `json:"request,omitempty"`
+	Action           string        `json:"action,omitempty"`
+	Category         *Ref          `json:"category,omitempty"`
+	Subcategory      *Ref          `json:"subcategory,omitempty"`
+	ProblemType      *Ref          `json:"problemType,omitempty"`
+	Cause            *Ref          `json:"cause,omitempty"`
+	Impact           *Ref          `json:"impact,omitempty"`
+	Urgency          *Ref          `json:"urgency,omitempty"`
+	Priority         *Ref          `json:"priority,omitempty"`
+	Operator         *Ref          `json:"operator,omitempty"`
+	OperatorGroup    *Ref          `json:"operatorGroup,omitempty"`
+	ProcessingStatus *Ref          `json:"processingStatus,omitempty"`
+	TargetDate       string        `json:"targetDate,omitempty"`
+	Completed        *bool         `json:"completed,omitempty"`
+	Closed           *bool         `json:"closed,omitempty"`
+}
+
+func (rc RestClient) UpdateProblem(ctx context.Context, request *UpdateProblemRequest) (*Problem, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "problems", request.ID)
+
+	response := &Problem{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ListProblemIncidents lists the incidents linked to a problem.
+func (rc RestClient) ListProblemIncidents(ctx context.Context, id string) (*IncidentIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "problems", id, "incidents")
+
+	it, err := rc.list(ctx, &uri)
+	return &IncidentIterator{it}, err
+}
+
+// ProblemIncidentsRequest links or unlinks incidents from a problem.
+type ProblemIncidentsRequest struct {
+	ID        string
+	Incidents []*Ref
+}
+
+func (rc RestClient) LinkProblemIncidents(ctx context.Context, request *ProblemIncidentsRequest) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "problems", request.ID, "incidents")
+
+	return rc.create(ctx, &uri, request.Incidents, nil)
+}
+
+func (rc RestClient) UnlinkProblemIncidents(ctx context.Context, request *ProblemIncidentsRequest) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "problems", request.ID, "incidents")
+
+	return rc.delete(ctx, &uri, request.Incidents)
+}
@@ -0,0 +1,253 @@
+package topdesk
+
+// Reservations.
+//
+// https://developers.topdesk.com/explorer/?page=reservations
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type ReservationIterator struct {
+	*ListIterator
+}
+
+// Reservation decodes the listed reservation.
+func (i ReservationIterator) Reservation() (*Reservation, error) {
+	response := &Reservation{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusRequested ReservationStatus = "requested"
+	ReservationStatusConfirmed ReservationStatus = "confirmed"
+	ReservationStatusCancelled ReservationStatus = "cancelled"
+)
+
+// Reservation of a location and/or assets for a time range.
+//
+// Location, Person and ReservedAssets are partial, only the ID and name (where the type has one) are set.
+type Reservation struct {
+	ID               string            `json:"id"`
+	Number           string            `json:"number"`
+	BriefDescription string            `json:"briefDescription"`
+	Description      string            `json:"description"`
+	Status           ReservationStatus `json:"status"`
+	Location         Location          `json:"location"`
+	Person           Person            `json:"person"`
+	PlannedStartDate string            `json:"plannedStartDate"`
+	PlannedEndDate   string            `json:"plannedEndDate"`
+	NumberOfPersons  int               `json:"numberOfPersons"`
+	ReservedAssets   []ReservableAsset `json:"reservedAssets"`
+	CreationDate     string            `json:"creationDate"`
+	ModificationDate string            `json:"modificationDate"`
+}
+
+func (r Reservation) Ref() *Ref {
+	return &Ref{ID: r.ID}
+}
+
+// ListReservationsRequest filters reservations, empty fields are ignored.
+//
+// From and To select reservations overlapping the range. Query is a FIQL expression and'ed with the other
+// filters.
+type ListReservationsRequest struct {
+	LocationID string
+	PersonID   string
+	AssetID    string
+	Status     ReservationStatus
+	From       time.Time
+	To         time.Time
+	Query      string
+}
+
+func (rc RestClient) ListReservations(ctx context.Context, request *ListReservationsRequest) (*ReservationIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "reservations")
+
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.
+			eq("location.id", request.LocationID).
+			eq("person.id", request.PersonID).
+			eq("reservedAssets.id", request.AssetID).
+			eq("status", string(request.Status))
+		if !request.From.IsZero() {
+			filter = filter.and(`plannedEndDate=gt="` + FormatTime(request.From) + `"`)
+		}
+		if !request.To.IsZero() {
+			filter = filter.and(`plannedStartDate=lt="` + FormatTime(request.To) + `"`)
+		}
+		filter = filter.and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.list(ctx, &uri)
+	return &ReservationIterator{it}, err
+}
+
+// ListLocationReservations in a time range, zero times are unbounded.
+func (rc RestClient) ListLocationReservations(ctx context.Context, locationID string, from, to time.Time) (*ReservationIterator, error) {
+	return rc.ListReservations(ctx, &ListReservationsRequest{LocationID: locationID, From: from, To: to})
+}
+
+// ListPersonReservations in a time range, zero times are unbounded.
+func (rc RestClient) ListPersonReservations(ctx context.Context, personID string, from, to time.Time) (*ReservationIterator, error) {
+	return rc.ListReservations(ctx, &ListReservationsRequest{PersonID: personID, From: from, To: to})
+}
+
+func (rc RestClient) GetReservation(ctx context.Context, id string) (*Reservation, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "reservations", id)
+
+	response := &Reservation{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateReservationRequest reserves a location and/or assets, dates use FormatTime.
+type CreateReservationRequest struct {
+	BriefDescription string `json:"briefDescription"`
+	Description      string `json:"description,omitempty"`
+	Location         *Ref   `json:"location,omitempty"`
+	Person           *Ref   `json:"person"`
+	PlannedStartDate string `json:"plannedStartDate"`
+	PlannedEndDate   string `json:"plannedEndDate"`
+	NumberOfPersons  int    `json:"numberOfPersons,omitempty"`
+	ReservedAssets   []*Ref `json:"reservedAssets,omitempty"`
+}
+
+func (rc RestClient) CreateReservation(ctx context.Context, request *CreateReservationRequest) (*Reservation, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "reservations")
+
+	response := &Reservation{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateReservationRequest patches a reservation, only non-zero fields are sent.
+type UpdateReservationRequest struct {
+	ID               string `json:"-"`
+	BriefDescription string `json:"briefDescription,omitempty"`
+	Description      string `json:"description,omitempty"`
+	Location         *Ref   `json:"location,omitempty"`
+	PlannedStartDate string `json:"plannedStartDate,omitempty"`
+	PlannedEndDate   string `json:"plannedEndDate,omitempty"`
+	NumberOfPersons  int    `json:"numberOfPersons,omitempty"`
+	ReservedAssets   []*Ref `json:"reservedAssets,omitempty"`
+}
+
+func (rc RestClient) UpdateReservation(ctx context.Context, request *UpdateReservationRequest) (*Reservation, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "reservations", request.ID)
+
+	response := &Reservation{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (rc RestClient) CancelReservation(ctx context.Context, id string) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "reservations", id, "cancel")
+
+	return rc.patch(ctx, &uri, nil, &json.RawMessage{})
+}
+
+// ReservableLocation is a location that can be booked.
+type ReservableLocation struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Capacity int    `json:"capacity"`
+	Branch   Branch `json:"branch"`
+}
+
+func (l ReservableLocation) Ref() *Ref {
+	return &Ref{ID: l.ID}
+}
+
+// ListReservableLocations optionally limited to a branch.
+func (rc RestClient) ListReservableLocations(ctx context.Context, branchID string) ([]ReservableLocation, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "reservableLocations")
+	if filter := (fiql{}).eq("branch.id", branchID); len(filter) > 0 {
+		query := uri.Query()
+		query.Set("query", filter.String())
+		uri.RawQuery = query.Encode()
+	}
+
+	response := []ReservableLocation{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ReservableAsset is an asset that can be booked e.g. a projector.
+type ReservableAsset struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (a ReservableAsset) Ref() *Ref {
+	return &Ref{ID: a.ID}
+}
+
+func (rc RestClient) ListReservableAssets(ctx context.Context) ([]ReservableAsset, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "reservableAssets")
+
+	response := []ReservableAsset{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CheckLocationAvailability returns the reservations that conflict with the time range, none means available.
+//
+// Cancelled reservations don't conflict.
+func (rc RestClient) CheckLocationAvailability(ctx context.Context, locationID string, from, to time.Time) ([]*Reservation, error) {
+	if !from.Before(to) {
+		return nil, errors.Errorf("check availability: %s is not before %s", from, to)
+	}
+
+	reservations, err := rc.ListLocationReservations(ctx, locationID, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "check availability")
+	}
+
+	conflicts := []*Reservation{}
+	for reservations.Next() {
+		reservation, err := reservations.Reservation()
+		if err != nil {
+			return nil, errors.Wrap(err, "check availability")
+		}
+		if reservation.Status != ReservationStatusCancelled {
+			conflicts = append(conflicts, reservation)
+		}
+	}
+	if err := reservations.Err(); err != nil {
+		return nil, errors.Wrap(err, "check availability")
+	}
+	return conflicts, nil
+}
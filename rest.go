@@ -6,8 +6,10 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
 	"sync"
 	"time"
@@ -19,6 +21,7 @@ import (
 type RestClient struct {
 	endpoint      *url.URL
 	authorization string
+	countries     *countryCache
 }
 
 // New REST client.
@@ -35,6 +38,7 @@ func NewRestClient(ctx context.Context, endpoint string, authorization string) (
 	rc := &RestClient{
 		endpoint:      uri,
 		authorization: fmt.Sprintf("Basic %s", authorization),
+		countries:     &countryCache{},
 	}
 
 	// TODO(shane): Test connection.
@@ -51,6 +55,34 @@ func (rc RestClient) FullyQualifiedURL(resource ResourceRelativeURL) *url.URL {
 	return &uri
 }
 
+// servicesURL for the newer module APIs that live under /services beside /tas/api.
+func (rc RestClient) servicesURL(elem ...string) url.URL {
+	uri := *rc.endpoint
+	root := strings.TrimSuffix(strings.TrimSuffix(uri.Path, "/"), "/tas/api")
+	uri.Path = path.Join(append([]string{root, "services"}, elem...)...)
+	return uri
+}
+
+// download streams a binary response body such as an attachment.
+func (rc RestClient) download(ctx context.Context, endpoint *url.URL, w io.Writer) error {
+	req, _ := http.NewRequest(http.MethodGet, endpoint.String(), nil)
+	req = req.WithContext(ctx)
+	req.Header.Add("Authorization", rc.authorization)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", endpoint.String())
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s download %s", http.StatusText(res.StatusCode), endpoint.String())
+	}
+	_, err = io.Copy(w, res.Body)
+	return errors.Wrapf(err, "GET %s", endpoint.String())
+}
+
 func (rc RestClient) do(context context.Context, method string, uri *url.URL, request interface{}, response interface{}) (int, error) {
 	body, err := json.Marshal(request)
 	if err != nil {
@@ -80,11 +112,14 @@ func (rc RestClient) do(context context.Context, method string, uri *url.URL, re
 		// Return a
 		messages := ErrorMessages{}
 		if err := json.NewDecoder(res.Body).Decode(&messages); err != nil {
-			return http.StatusBadRequest, errors.Wrapf(err, "%s %s decoding response body", method, uri.String())
+			return res.StatusCode, errors.Wrapf(err, "%s %s %s decoding response body", method, uri.String(), http.StatusText(res.StatusCode))
 		}
 		return res.StatusCode, messages
 
 	case http.StatusOK, http.StatusCreated, http.StatusPartialContent:
+		if response == nil {
+			break
+		}
 		if err := json.NewDecoder(res.Body).Decode(response); err != nil {
 			return http.StatusBadRequest, errors.Wrapf(err, "%s %s decoding response body", method, uri.String())
 		}
@@ -105,12 +140,25 @@ func (rc RestClient) get(ctx context.Context, endpoint *url.URL, response interf
 	}
 }
 
+// getAll fetches an unpaginated collection, no content is an empty collection not an error.
+func (rc RestClient) getAll(ctx context.Context, endpoint *url.URL, response interface{}) error {
+	status, err := rc.do(ctx, http.MethodGet, endpoint, nil, response)
+	switch {
+	case err != nil:
+		return err
+	case status == http.StatusOK || status == http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("%s list %s", http.StatusText(status), endpoint.String())
+	}
+}
+
 func (rc RestClient) create(ctx context.Context, endpoint *url.URL, request interface{}, response interface{}) error {
 	status, err := rc.do(ctx, http.MethodPost, endpoint, request, response)
 	switch {
 	case err != nil:
 		return err
-	case status == http.StatusOK || status == http.StatusCreated:
+	case status == http.StatusOK || status == http.StatusCreated || status == http.StatusNoContent:
 		return nil
 	default:
 		return fmt.Errorf("%s save %s", http.StatusText(status), endpoint.String())
@@ -122,19 +170,31 @@ func (rc RestClient) update(ctx context.Context, endpoint *url.URL, request inte
 	switch {
 	case err != nil:
 		return err
-	case status == http.StatusOK:
+	case status == http.StatusOK || status == http.StatusNoContent:
 		return nil
 	default:
 		return fmt.Errorf("%s save %s", http.StatusText(status), endpoint.String())
 	}
 }
 
-func (rc *RestClient) delete(ctx context.Context, endpoint *url.URL) error {
-	status, err := rc.do(ctx, http.MethodDelete, endpoint, nil, nil)
+func (rc RestClient) patch(ctx context.Context, endpoint *url.URL, request interface{}, response interface{}) error {
+	status, err := rc.do(ctx, http.MethodPatch, endpoint, request, response)
 	switch {
 	case err != nil:
 		return err
-	case status == http.StatusOK:
+	case status == http.StatusOK || status == http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("%s save %s", http.StatusText(status), endpoint.String())
+	}
+}
+
+func (rc *RestClient) delete(ctx context.Context, endpoint *url.URL, request interface{}) error {
+	status, err := rc.do(ctx, http.MethodDelete, endpoint, request, nil)
+	switch {
+	case err != nil:
+		return err
+	case status == http.StatusOK || status == http.StatusNoContent:
 		return nil
 	default:
 		return fmt.Errorf("%s save %s", http.StatusText(status), endpoint.String())
@@ -143,25 +203,42 @@ func (rc *RestClient) delete(ctx context.Context, endpoint *url.URL) error {
 
 func (rc RestClient) list(ctx context.Context, endpoint *url.URL) (*ListIterator, error) {
 	return &ListIterator{
-		start:    0,
-		pageSize: 100, // Magic number, but it's Topdesk max.
-		client:   &rc,
-		ctx:      ctx,
-		more:     true,
-		endpoint: endpoint,
-		data:     make([]json.RawMessage, 0),
+		start:      0,
+		pageSize:   100, // Magic number, but it's Topdesk max.
+		startParam: "start",
+		sizeParam:  "page_size",
+		client:     &rc,
+		ctx:        ctx,
+		more:       true,
+		endpoint:   endpoint,
+		data:       make([]json.RawMessage, 0),
 	}, nil
 }
 
+// listEnvelope lists newer module endpoints that wrap each page in an object e.g. {"dataSet": [...]}.
+//
+// These endpoints don't return 206 so a short page is the last page.
+func (rc RestClient) listEnvelope(ctx context.Context, endpoint *url.URL, envelope, startParam, sizeParam string) (*ListIterator, error) {
+	it, err := rc.list(ctx, endpoint)
+	it.envelope = envelope
+	it.startParam = startParam
+	it.sizeParam = sizeParam
+	return it, err
+}
+
 type ListIterator struct {
-	client   *RestClient
-	start    uint64
-	pageSize uint64
-	more     bool
-	endpoint *url.URL
-	ctx      context.Context
-	mu       sync.Mutex
-	data     []json.RawMessage
+	client     *RestClient
+	start      uint64
+	pageSize   uint64
+	startParam string
+	sizeParam  string
+	envelope   string
+	more       bool
+	endpoint   *url.URL
+	ctx        context.Context
+	mu         sync.Mutex
+	data       []json.RawMessage
+	err        error
 }
 
 func (l *ListIterator) decode(response interface{}) error {
@@ -180,25 +257,60 @@ func (l *ListIterator) decode(response interface{}) error {
 }
 
 func (l *ListIterator) Next() bool {
+	if l.err != nil {
+		return false
+	}
+
 	if len(l.data) == 0 && l.more {
 		uri := *l.endpoint
 
 		query := uri.Query()
-		query.Set("page_size", fmt.Sprintf("%d", l.pageSize))
-		query.Set("start", fmt.Sprintf("%d", l.start))
+		query.Set(l.sizeParam, fmt.Sprintf("%d", l.pageSize))
+		query.Set(l.startParam, fmt.Sprintf("%d", l.start))
 		uri.RawQuery = query.Encode()
 
-		status, err := l.client.do(l.ctx, http.MethodGet, &uri, nil, &l.data)
-		if err != nil {
-			return false
+		if l.envelope == "" {
+			status, err := l.client.do(l.ctx, http.MethodGet, &uri, nil, &l.data)
+			if err == nil && status != http.StatusOK && status != http.StatusPartialContent && status != http.StatusNoContent {
+				err = fmt.Errorf("%s list %s", http.StatusText(status), uri.String())
+			}
+			if err != nil {
+				l.err = err
+				return false
+			}
+			l.more = (status == http.StatusPartialContent)
+		} else {
+			page := map[string]json.RawMessage{}
+			status, err := l.client.do(l.ctx, http.MethodGet, &uri, nil, &page)
+			if err == nil && status != http.StatusOK && status != http.StatusNoContent {
+				err = fmt.Errorf("%s list %s", http.StatusText(status), uri.String())
+			}
+			if err != nil {
+				l.err = err
+				return false
+			}
+			l.data = l.data[:0]
+			if raw, ok := page[l.envelope]; ok {
+				if err := json.Unmarshal(raw, &l.data); err != nil {
+					l.err = errors.Wrapf(err, "GET %s decoding %s", uri.String(), l.envelope)
+					return false
+				}
+			}
+			l.more = uint64(len(l.data)) == l.pageSize
 		}
 		l.start = l.start + l.pageSize
-		l.more = (status == http.StatusPartialContent)
 	}
 
 	return len(l.data) > 0
 }
 
+// Err returns the error, if any, that stopped Next fetching the next page.
+//
+// Like sql.Rows check it after the Next loop, a false Next is otherwise indistinguishable from the end of the list.
+func (l *ListIterator) Err() error {
+	return l.err
+}
+
 // ErrorMessages REST API response.
 type ErrorMessages []struct {
 	Message string `json:"message"`
@@ -212,9 +324,65 @@ func (e ErrorMessages) Error() string {
 	return strings.Join(errs, " ")
 }
 
+// NotFoundError is returned by Find* lookups when no resource matches.
+type NotFoundError struct {
+	Resource string
+	Query    string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("%s %s not found", e.Resource, e.Query)
+}
+
 // Ref is a resource reference.
 type Ref struct {
-	ID string `"id"`
+	ID string `json:"id"`
+}
+
+// fiql builds a FIQL query string, each constraint is and'ed.
+type fiql []string
+
+// eq adds field==value unless the value is empty.
+func (f fiql) eq(field string, value string) fiql {
+	if value == "" {
+		return f
+	}
+	if strings.ContainsAny(value, ` "'();,=!<>*`) {
+		value = `"` + strings.Replace(value, `"`, `\"`, -1) + `"`
+	}
+	return append(f, field+"=="+value)
+}
+
+// and adds a raw FIQL expression unless it's empty.
+//
+// The expression is parenthesized so an OR (,) can't bind to the other filters.
+func (f fiql) and(expression string) fiql {
+	if expression == "" {
+		return f
+	}
+	return append(f, "("+expression+")")
+}
+
+func (f fiql) String() string {
+	return strings.Join(f, ";")
+}
+
+// TimeLayout is the date time format used by Topdesk.
+const TimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// FormatTime for date fields in requests.
+func FormatTime(t time.Time) string {
+	return t.Format(TimeLayout)
+}
+
+// ParseTime from a date field in a response.
+func ParseTime(value string) (time.Time, error) {
+	return time.Parse(TimeLayout, value)
+}
+
+// Bool returns a pointer to v for optional fields in patch requests.
+func Bool(v bool) *bool {
+	return &v
 }
 
 // ResourceRef creates a reference from any resource that implements the interface.
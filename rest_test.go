@@ -0,0 +1,99 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testClient returns a client for a fake Topdesk, close the server when done.
+func testClient(t *testing.T, handler http.HandlerFunc) (*RestClient, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	client, err := NewRestClient(context.Background(), server.URL+"/tas/api", "user:token")
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	return client, server
+}
+
+func TestListIteratorErr(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   int
+		errors bool
+	}{
+		{name: "last page", status: http.StatusOK, want: 2},
+		{name: "no content", status: http.StatusNoContent, want: 1},
+		{name: "server error", status: http.StatusInternalServerError, want: 1, errors: true},
+		{name: "unexpected status", status: http.StatusAccepted, want: 1, errors: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("start") == "0" {
+					w.WriteHeader(http.StatusPartialContent)
+					json.NewEncoder(w).Encode([]Ref{{ID: "1"}})
+					return
+				}
+				w.WriteHeader(test.status)
+				switch test.status {
+				case http.StatusOK:
+					json.NewEncoder(w).Encode([]Ref{{ID: "2"}})
+				case http.StatusInternalServerError:
+					json.NewEncoder(w).Encode(ErrorMessages{})
+				}
+			})
+			defer server.Close()
+
+			branches, err := client.ListBranches(context.Background(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := 0
+			for branches.Next() {
+				if err := branches.decode(&json.RawMessage{}); err != nil {
+					t.Fatal(err)
+				}
+				got++
+			}
+			if got != test.want {
+				t.Errorf("got %d rows, want %d", got, test.want)
+			}
+			if err := branches.Err(); (err != nil) != test.errors {
+				t.Errorf("got error %v, want error %t", err, test.errors)
+			}
+			if branches.Next() {
+				t.Error("Next after the end got true")
+			}
+		})
+	}
+}
+
+func TestFIQL(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter fiql
+		want   string
+	}{
+		{name: "empty", filter: fiql{}.eq("name", "").and(""), want: ""},
+		{name: "eq", filter: fiql{}.eq("name", "Apple"), want: "name==Apple"},
+		{name: "eq quoted", filter: fiql{}.eq("name", `Apple "A" Arthurton`), want: `name=="Apple \"A\" Arthurton"`},
+		{name: "and", filter: fiql{}.eq("branch.id", "b1").eq("name", "Apple"), want: "branch.id==b1;name==Apple"},
+		{name: "and or", filter: fiql{}.eq("branch.id", "b1").and("name==Apple,name==Bob"), want: "branch.id==b1;(name==Apple,name==Bob)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.filter.String(); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
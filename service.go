@@ -0,0 +1,325 @@
+package topdesk
+
+// Service catalog and service level management.
+//
+// https://developers.topdesk.com/explorer/?page=slm
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type ServiceIterator struct {
+	*ListIterator
+}
+
+// Service decodes the listed service.
+func (i ServiceIterator) Service() (*Service, error) {
+	response := &Service{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type Service struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"status"`
+	Owner struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"owner"`
+	Archived bool `json:"archived"`
+}
+
+func (s Service) Ref() *Ref {
+	return &Ref{ID: s.ID}
+}
+
+type ListServicesRequest struct {
+	Name  string
+	Query string
+}
+
+func (rc RestClient) ListServices(ctx context.Context, request *ListServicesRequest) (*ServiceIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "services")
+
+	if request != nil {
+		if filter := (fiql{}).eq("name", request.Name).and(request.Query); len(filter) > 0 {
+			query := uri.Query()
+			query.Set("query", filter.String())
+			uri.RawQuery = query.Encode()
+		}
+	}
+
+	it, err := rc.list(ctx, &uri)
+	return &ServiceIterator{it}, err
+}
+
+func (rc RestClient) GetService(ctx context.Context, id string) (*Service, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "services", "id", id)
+
+	response := &Service{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// ServiceWindowDay is the service hours for a day of the week, Start and End are "15:04".
+type ServiceWindowDay struct {
+	Day   string `json:"day"` // Lower case English weekday e.g. monday.
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ServiceWindow is the hours SLA targets are measured in.
+type ServiceWindow struct {
+	ID   string             `json:"id"`
+	Name string             `json:"name"`
+	Days []ServiceWindowDay `json:"days"`
+}
+
+// Add d of service time to start, skipping time outside the window.
+//
+// An empty window is treated as 24x7. Times are evaluated in start's location. Spans of a day may be listed in any
+// order, overlapping spans are merged.
+func (w ServiceWindow) Add(start time.Time, d time.Duration) (time.Time, error) {
+	if len(w.Days) == 0 {
+		return start.Add(d), nil
+	}
+
+	hours := map[time.Weekday][][2]time.Duration{}
+	for _, day := range w.Days {
+		weekday, ok := weekdays[strings.ToLower(day.Day)]
+		if !ok {
+			return time.Time{}, errors.Errorf("service window %s: unknown day %s", w.Name, day.Day)
+		}
+		from, err := clock(day.Start)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, "service window %s", w.Name)
+		}
+		to, err := clock(day.End)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, "service window %s", w.Name)
+		}
+		if to == 0 {
+			to = 24 * time.Hour // 00:00 end is midnight.
+		}
+		if to < from {
+			return time.Time{}, errors.Errorf("service window %s: %s ends %s before it starts %s", w.Name, day.Day, day.End, day.Start)
+		}
+		hours[weekday] = append(hours[weekday], [2]time.Duration{from, to})
+	}
+	for weekday, spans := range hours {
+		hours[weekday] = mergeSpans(spans)
+	}
+
+	current := start
+	for i := 0; i < 366*8; i++ { // Bounded in case the window is all zero length.
+		midnight := time.Date(current.Year(), current.Month(), current.Day(), 0, 0, 0, 0, current.Location())
+		for _, span := range hours[current.Weekday()] {
+			from, to := midnight.Add(span[0]), midnight.Add(span[1])
+			if !current.Before(to) {
+				continue
+			}
+			if current.Before(from) {
+				current = from
+			}
+			remaining := to.Sub(current)
+			if d <= remaining {
+				return current.Add(d), nil
+			}
+			d -= remaining
+			current = to
+		}
+		current = midnight.AddDate(0, 0, 1)
+	}
+	return time.Time{}, errors.Errorf("service window %s: no service hours", w.Name)
+}
+
+// mergeSpans sorts spans by start and merges overlapping or adjacent spans.
+func mergeSpans(spans [][2]time.Duration) [][2]time.Duration {
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+
+	merged := spans[:0]
+	for _, span := range spans {
+		if last := len(merged) - 1; last >= 0 && span[0] <= merged[last][1] {
+			if span[1] > merged[last][1] {
+				merged[last][1] = span[1]
+			}
+			continue
+		}
+		merged = append(merged, span)
+	}
+	return merged
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+func clock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ServiceLevelTarget for incidents of a priority, times are minutes of service time.
+type ServiceLevelTarget struct {
+	Priority struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"priority"`
+	ResponseTime   int `json:"responseTime"`
+	ResolutionTime int `json:"resolutionTime"`
+}
+
+func (t ServiceLevelTarget) Response() time.Duration {
+	return time.Duration(t.ResponseTime) * time.Minute
+}
+
+func (t ServiceLevelTarget) Resolution() time.Duration {
+	return time.Duration(t.ResolutionTime) * time.Minute
+}
+
+type ServiceLevelAgreementIterator struct {
+	*ListIterator
+}
+
+// ServiceLevelAgreement decodes the listed SLA.
+func (i ServiceLevelAgreementIterator) ServiceLevelAgreement() (*ServiceLevelAgreement, error) {
+	response := &ServiceLevelAgreement{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type ServiceLevelAgreement struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Service struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"service"`
+	StartDate     string               `json:"startDate"`
+	EndDate       string               `json:"endDate"`
+	Targets       []ServiceLevelTarget `json:"targets"`
+	ServiceWindow ServiceWindow        `json:"serviceWindow"`
+	Archived      bool                 `json:"archived"`
+}
+
+func (s ServiceLevelAgreement) Ref() *Ref {
+	return &Ref{ID: s.ID}
+}
+
+// Target for a priority ID.
+func (s ServiceLevelAgreement) Target(priorityID string) (ServiceLevelTarget, bool) {
+	for _, target := range s.Targets {
+		if target.Priority.ID == priorityID {
+			return target, true
+		}
+	}
+	return ServiceLevelTarget{}, false
+}
+
+// Deadlines for response and resolution of a call made at start with the priority.
+func (s ServiceLevelAgreement) Deadlines(start time.Time, priorityID string) (response time.Time, resolution time.Time, err error) {
+	target, ok := s.Target(priorityID)
+	if !ok {
+		return time.Time{}, time.Time{}, NotFoundError{Resource: "sla target", Query: "priority " + priorityID}
+	}
+	if response, err = s.ServiceWindow.Add(start, target.Response()); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if resolution, err = s.ServiceWindow.Add(start, target.Resolution()); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return response, resolution, nil
+}
+
+type ListServiceLevelAgreementsRequest struct {
+	ServiceID string
+	Query     string
+}
+
+func (rc RestClient) ListServiceLevelAgreements(ctx context.Context, request *ListServiceLevelAgreementsRequest) (*ServiceLevelAgreementIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "slas")
+
+	if request != nil {
+		if filter := (fiql{}).eq("service.id", request.ServiceID).and(request.Query); len(filter) > 0 {
+			query := uri.Query()
+			query.Set("query", filter.String())
+			uri.RawQuery = query.Encode()
+		}
+	}
+
+	it, err := rc.list(ctx, &uri)
+	return &ServiceLevelAgreementIterator{it}, err
+}
+
+func (rc RestClient) GetServiceLevelAgreement(ctx context.Context, id string) (*ServiceLevelAgreement, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "slas", "id", id)
+
+	response := &ServiceLevelAgreement{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// GetIncidentServiceLevelAgreement resolves Incident.SLA.
+func (rc RestClient) GetIncidentServiceLevelAgreement(ctx context.Context, incident *Incident) (*ServiceLevelAgreement, error) {
+	if incident.SLA.ID == "" {
+		return nil, NotFoundError{Resource: "sla", Query: "incident " + incident.Number}
+	}
+	return rc.GetServiceLevelAgreement(ctx, incident.SLA.ID)
+}
+
+// CountIncidentsByService tallies incidents by the service of their SLA, incidents without an SLA are counted
+// under the empty service ID.
+func (rc RestClient) CountIncidentsByService(ctx context.Context, incidents *IncidentIterator) (map[string]int, error) {
+	services := map[string]string{} // SLA ID to service ID.
+	counts := map[string]int{}
+	for incidents.Next() {
+		incident, err := incidents.Incident()
+		if err != nil {
+			return nil, errors.Wrap(err, "count incidents by service")
+		}
+
+		sla := incident.SLA.ID
+		service, ok := services[sla]
+		if !ok && sla != "" {
+			agreement, err := rc.GetServiceLevelAgreement(ctx, sla)
+			if err != nil {
+				return nil, errors.Wrap(err, "count incidents by service")
+			}
+			service = agreement.Service.ID
+			services[sla] = service
+		}
+		counts[service]++
+	}
+	if err := incidents.Err(); err != nil {
+		return nil, errors.Wrap(err, "count incidents by service")
+	}
+	return counts, nil
+}
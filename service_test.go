@@ -0,0 +1,148 @@
+package topdesk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestServiceWindowAdd(t *testing.T) {
+	monday := time.Date(2024, time.January, 1, 8, 0, 0, 0, time.UTC) // A Monday.
+
+	tests := []struct {
+		name   string
+		days   []ServiceWindowDay
+		start  time.Time
+		d      time.Duration
+		want   time.Time
+		errors bool
+	}{
+		{
+			name:  "24x7",
+			start: monday,
+			d:     30 * time.Hour,
+			want:  monday.Add(30 * time.Hour),
+		},
+		{
+			name:  "before window opens",
+			days:  []ServiceWindowDay{{Day: "monday", Start: "09:00", End: "17:00"}},
+			start: monday,
+			d:     time.Hour,
+			want:  monday.Add(2 * time.Hour),
+		},
+		{
+			name: "spans out of order",
+			days: []ServiceWindowDay{
+				{Day: "monday", Start: "13:00", End: "17:00"},
+				{Day: "monday", Start: "09:00", End: "12:00"},
+			},
+			start: monday,
+			d:     time.Hour,
+			want:  monday.Add(2 * time.Hour),
+		},
+		{
+			name: "lunch break skipped",
+			days: []ServiceWindowDay{
+				{Day: "monday", Start: "13:00", End: "17:00"},
+				{Day: "monday", Start: "09:00", End: "12:00"},
+			},
+			start: monday,
+			d:     4 * time.Hour,
+			want:  monday.Add(6 * time.Hour),
+		},
+		{
+			name: "overlapping spans merged",
+			days: []ServiceWindowDay{
+				{Day: "Monday", Start: "09:00", End: "12:00"},
+				{Day: "monday", Start: "11:00", End: "13:00"},
+			},
+			start: monday,
+			d:     4 * time.Hour,
+			want:  monday.Add(5 * time.Hour),
+		},
+		{
+			name: "rolls over to next service day",
+			days: []ServiceWindowDay{
+				{Day: "monday", Start: "09:00", End: "17:00"},
+				{Day: "wednesday", Start: "09:00", End: "17:00"},
+			},
+			start: monday,
+			d:     10 * time.Hour,
+			want:  monday.AddDate(0, 0, 2).Add(3 * time.Hour),
+		},
+		{
+			name:  "midnight end",
+			days:  []ServiceWindowDay{{Day: "monday", Start: "22:00", End: "00:00"}},
+			start: monday,
+			d:     2 * time.Hour,
+			want:  monday.Add(16 * time.Hour),
+		},
+		{
+			name:   "unknown day",
+			days:   []ServiceWindowDay{{Day: "funday", Start: "09:00", End: "17:00"}},
+			start:  monday,
+			errors: true,
+		},
+		{
+			name:   "ends before start",
+			days:   []ServiceWindowDay{{Day: "monday", Start: "17:00", End: "09:00"}},
+			start:  monday,
+			errors: true,
+		},
+		{
+			name:   "no service hours",
+			days:   []ServiceWindowDay{{Day: "monday", Start: "09:00", End: "09:00"}},
+			start:  monday,
+			d:      time.Hour,
+			errors: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			window := ServiceWindow{Name: "test", Days: test.days}
+			got, err := window.Add(test.start, test.d)
+			if test.errors {
+				if err == nil {
+					t.Fatalf("got %s, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestServiceLevelAgreementDeadlines(t *testing.T) {
+	sla := ServiceLevelAgreement{
+		ServiceWindow: ServiceWindow{Days: []ServiceWindowDay{
+			{Day: "monday", Start: "09:00", End: "17:00"},
+			{Day: "tuesday", Start: "09:00", End: "17:00"},
+		}},
+	}
+	target := ServiceLevelTarget{ResponseTime: 60, ResolutionTime: 5 * 60}
+	target.Priority.ID = "p1"
+	sla.Targets = []ServiceLevelTarget{target}
+
+	monday := time.Date(2024, time.January, 1, 16, 0, 0, 0, time.UTC)
+	response, resolution, err := sla.Deadlines(monday, "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := monday.Add(time.Hour); !response.Equal(want) {
+		t.Errorf("response got %s, want %s", response, want)
+	}
+	if want := monday.Add(21 * time.Hour); !resolution.Equal(want) {
+		t.Errorf("resolution got %s, want %s", resolution, want)
+	}
+
+	if _, _, err := sla.Deadlines(monday, "p2"); err == nil {
+		t.Error("unknown priority got nil error")
+	} else if _, ok := err.(NotFoundError); !ok {
+		t.Errorf("unknown priority got %T, want NotFoundError", err)
+	}
+}
@@ -0,0 +1,36 @@
+package topdesk
+
+import (
+	"context"
+	"path"
+)
+
+type ArchivingReasonIterator struct {
+	*ListIterator
+}
+
+func (i ArchivingReasonIterator) ArchivingReason() (*ArchivingReason, error) {
+	response := &ArchivingReason{}
+	err := i.decode(&response)
+	return response, err
+}
+
+// ArchivingReason is required when archiving persons, operators, branches and locations.
+type ArchivingReason struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (a ArchivingReason) Ref() *Ref {
+	return &Ref{ID: a.ID}
+}
+
+type ListArchivingReasonsRequest struct{}
+
+func (rc RestClient) ListArchivingReasons(ctx context.Context, request *ListArchivingReasonsRequest) (*ArchivingReasonIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "archiving-reasons")
+
+	it, err := rc.list(ctx, &uri)
+	return &ArchivingReasonIterator{it}, err
+}
@@ -7,6 +7,8 @@ package topdesk
 import (
 	"context"
 	"path"
+
+	"github.com/pkg/errors"
 )
 
 type BranchIterator struct {
@@ -25,38 +27,40 @@ func (i BranchIterator) Branch() (*Branch, error) {
 //
 // https://developers.topdesk.com/explorer/?page=supporting-files#/Branches/retrieveBranches
 type Branch struct {
-	ID                    string `json:"id,omitempty"`
-	Name                  string `json:"name"`
-	Specification         string `json:"specification"`
-	ClientReferenceNumber string `json:"clientReferenceNumber"`
-	Phone                 string `json:"phone"`
-	Fax                   string `json:"fax"`
-	Email                 string `json:"email"`
-	Website               string `json:"website"`
-	BranchType            string `json:"branchType"`
-	HeadBranch            *Ref   `json:"headBranch"`
-	Address               struct {
-		Country struct {
-			ID string `json:"id"`
-		} `json:"country"`
-		Street      string `json:"street"`
-		Number      string `json:"number"`
-		County      string `json:"county"`
-		City        string `json:"city"`
-		Postcode    string `json:"postcode"`
-		AddressMemo string `json:"addressMemo"`
-	} `json:"address"`
-	PostalAddress struct {
-		Country struct {
-			ID string `json:"id"`
-		} `json:"country"`
-		Street      string `json:"street"`
-		Number      string `json:"number"`
-		County      string `json:"county"`
-		City        string `json:"city"`
-		Postcode    string `json:"postcode"`
-		AddressMemo string `json:"addressMemo"`
-	} `json:"postalAddress"`
+	ID                    string        `json:"id,omitempty"`
+	Name                  string        `json:"name"`
+	Specification         string        `json:"specification"`
+	ClientReferenceNumber string        `json:"clientReferenceNumber"`
+	Phone                 string        `json:"phone"`
+	Fax                   string        `json:"fax"`
+	Email                 string        `json:"email"`
+	Website               string        `json:"website"`
+	BranchType            string        `json:"branchType"`
+	HeadBranch            *Ref          `json:"headBranch"`
+	Address               BranchAddress `json:"address"`
+	PostalAddress         BranchAddress `json:"postalAddress"`
+}
+
+// BranchAddress for Branch.Address and Branch.PostalAddress.
+type BranchAddress struct {
+	Country     Ref    `json:"country"`
+	Street      string `json:"street"`
+	Number      string `json:"number"`
+	County      string `json:"county"`
+	City        string `json:"city"`
+	Postcode    string `json:"postcode"`
+	AddressMemo string `json:"addressMemo"`
+}
+
+// BranchAddressRequest sets an address on create and update requests, only non-zero fields are sent.
+type BranchAddressRequest struct {
+	Country     *Ref   `json:"country,omitempty"`
+	Street      string `json:"street,omitempty"`
+	Number      string `json:"number,omitempty"`
+	County      string `json:"county,omitempty"`
+	City        string `json:"city,omitempty"`
+	Postcode    string `json:"postcode,omitempty"`
+	AddressMemo string `json:"addressMemo,omitempty"`
 }
 
 func (b Branch) Ref() *Ref {
@@ -81,3 +85,124 @@ func (rc RestClient) GetBranch(ctx context.Context, id string) (*Branch, error)
 	err := rc.get(ctx, &uri, response)
 	return response, err
 }
+
+// CreateBranchRequest creates a branch, Name and BranchType are required.
+//
+// See https://developers.topdesk.com/explorer/?page=supporting-files#/Branches/createBranch
+type CreateBranchRequest struct {
+	Name                  string                `json:"name"`
+	Specification         string                `json:"specification,omitempty"`
+	ClientReferenceNumber string                `json:"clientReferenceNumber,omitempty"`
+	Phone                 string                `json:"phone,omitempty"`
+	Fax                   string                `json:"fax,omitempty"`
+	Email                 string                `json:"email,omitempty"`
+	Website               string                `json:"website,omitempty"`
+	BranchType            string                `json:"branchType"`
+	HeadBranch            *Ref                  `json:"headBranch,omitempty"`
+	Address               *BranchAddressRequest `json:"address,omitempty"`
+	PostalAddress         *BranchAddressRequest `json:"postalAddress,omitempty"`
+}
+
+func (rc RestClient) CreateBranch(ctx context.Context, request *CreateBranchRequest) (*Branch, error) {
+	if err := rc.validateBranchCountries(ctx, request.Address, request.PostalAddress); err != nil {
+		return nil, err
+	}
+
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "branches")
+
+	response := &Branch{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateBranchRequest patches a branch, only non-zero fields are sent.
+type UpdateBranchRequest struct {
+	ID                    string                `json:"-"`
+	Name                  string                `json:"name,omitempty"`
+	Specification         string                `json:"specification,omitempty"`
+	ClientReferenceNumber string                `json:"clientReferenceNumber,omitempty"`
+	Phone                 string                `json:"phone,omitempty"`
+	Fax                   string                `json:"fax,omitempty"`
+	Email                 string                `json:"email,omitempty"`
+	Website               string                `json:"website,omitempty"`
+	BranchType            string                `json:"branchType,omitempty"`
+	HeadBranch            *Ref                  `json:"headBranch,omitempty"`
+	Address               *BranchAddressRequest `json:"address,omitempty"`
+	PostalAddress         *BranchAddressRequest `json:"postalAddress,omitempty"`
+}
+
+func (rc RestClient) UpdateBranch(ctx context.Context, request *UpdateBranchRequest) (*Branch, error) {
+	if err := rc.validateBranchCountries(ctx, request.Address, request.PostalAddress); err != nil {
+		return nil, err
+	}
+
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "branches", "id", request.ID)
+
+	response := &Branch{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ArchiveBranchRequest archives a branch, Reason is an ArchivingReason ref.
+type ArchiveBranchRequest struct {
+	ID     string
+	Reason *Ref
+}
+
+func (rc RestClient) ArchiveBranch(ctx context.Context, request *ArchiveBranchRequest) (*Branch, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "branches", "id", request.ID, "archive")
+
+	response := &Branch{}
+	if err := rc.patch(ctx, &uri, request.Reason, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (rc RestClient) UnarchiveBranch(ctx context.Context, id string) (*Branch, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "branches", "id", id, "unarchive")
+
+	response := &Branch{}
+	if err := rc.patch(ctx, &uri, nil, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// validateBranchCountries checks address country refs exist before Topdesk 400s with a less helpful message.
+//
+// Countries come from the client's cached CountryDirectory so bulk creates and updates only list them once.
+func (rc RestClient) validateBranchCountries(ctx context.Context, addresses ...*BranchAddressRequest) error {
+	wanted := []string{}
+	for _, address := range addresses {
+		if address != nil && address.Country != nil {
+			wanted = append(wanted, address.Country.ID)
+		}
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+
+	countries, err := rc.CountryDirectory(ctx)
+	if err != nil {
+		return errors.Wrap(err, "validate branch countries")
+	}
+	for _, id := range wanted {
+		if _, ok := countries.ByID(id); !ok {
+			return NotFoundError{Resource: "country", Query: "id " + id}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreateBranchValidatesCountries(t *testing.T) {
+	lists, creates := 0, 0
+	client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tas/api/countries":
+			lists++
+			json.NewEncoder(w).Encode([]Country{{ID: "nl", Name: "Nederland"}})
+		case "/tas/api/branches":
+			creates++
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(Branch{ID: "b1"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(ErrorMessages{})
+		}
+	})
+	defer server.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err := client.CreateBranch(context.Background(), &CreateBranchRequest{
+			Name:    "Amsterdam",
+			Address: &BranchAddressRequest{Country: &Ref{ID: "nl"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := client.CreateBranch(context.Background(), &CreateBranchRequest{
+		Name:          "Atlantis",
+		PostalAddress: &BranchAddressRequest{Country: &Ref{ID: "xx"}},
+	})
+	if _, ok := err.(NotFoundError); !ok {
+		t.Errorf("unknown country got %v, want NotFoundError", err)
+	}
+
+	if lists != 1 || creates != 3 {
+		t.Errorf("got %d country lists and %d creates, want 1 and 3", lists, creates)
+	}
+}
@@ -0,0 +1,183 @@
+package topdesk
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// BranchNode is a branch in a BranchTree.
+type BranchNode struct {
+	Branch   *Branch
+	Parent   *BranchNode
+	Children []*BranchNode
+}
+
+// Depth of the node, roots and orphans are zero.
+func (n *BranchNode) Depth() int {
+	depth := 0
+	for p := n.Parent; p != nil; p = p.Parent {
+		depth++
+	}
+	return depth
+}
+
+// Ancestors from the head branch up to the root.
+func (n *BranchNode) Ancestors() []*BranchNode {
+	ancestors := []*BranchNode{}
+	for p := n.Parent; p != nil; p = p.Parent {
+		ancestors = append(ancestors, p)
+	}
+	return ancestors
+}
+
+// Root of the branch, the node itself if it has no head branch.
+func (n *BranchNode) Root() *BranchNode {
+	root := n
+	for root.Parent != nil {
+		root = root.Parent
+	}
+	return root
+}
+
+// Descendants depth first, not including the node itself.
+func (n *BranchNode) Descendants() []*BranchNode {
+	descendants := []*BranchNode{}
+	for _, child := range n.Children {
+		descendants = append(descendants, child)
+		descendants = append(descendants, child.Descendants()...)
+	}
+	return descendants
+}
+
+// BranchTree is the organisation hierarchy reconstructed from Branch.HeadBranch.
+//
+// Roots have no head branch. Orphans reference a head branch that isn't in the list (archived or filtered) or
+// are part of a head branch cycle, they are detached and have no parent.
+type BranchTree struct {
+	Roots   []*BranchNode
+	Orphans []*BranchNode
+
+	byID                    map[string]*BranchNode
+	byName                  map[string][]*BranchNode
+	byClientReferenceNumber map[string]*BranchNode
+}
+
+// NewBranchTree builds a tree from a list of branches.
+func NewBranchTree(branches []*Branch) *BranchTree {
+	tree := &BranchTree{
+		byID:                    map[string]*BranchNode{},
+		byName:                  map[string][]*BranchNode{},
+		byClientReferenceNumber: map[string]*BranchNode{},
+	}
+
+	nodes := make([]*BranchNode, 0, len(branches))
+	for _, branch := range branches {
+		node := &BranchNode{Branch: branch}
+		nodes = append(nodes, node)
+		tree.byID[branch.ID] = node
+		tree.byName[branch.Name] = append(tree.byName[branch.Name], node)
+		if branch.ClientReferenceNumber != "" {
+			tree.byClientReferenceNumber[branch.ClientReferenceNumber] = node
+		}
+	}
+	sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].Branch.Name < nodes[j].Branch.Name })
+
+	for _, node := range nodes {
+		head := headBranchID(node.Branch.HeadBranch)
+		parent, ok := tree.byID[head]
+		switch {
+		case head == "" || head == node.Branch.ID:
+			tree.Roots = append(tree.Roots, node)
+		case !ok:
+			tree.Orphans = append(tree.Orphans, node)
+		default:
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	// Anything not reachable from a root or orphan is in a head branch cycle.
+	reachable := map[*BranchNode]bool{}
+	var mark func(nodes []*BranchNode)
+	mark = func(nodes []*BranchNode) {
+		for _, node := range nodes {
+			if !reachable[node] {
+				reachable[node] = true
+				mark(node.Children)
+			}
+		}
+	}
+	mark(tree.Roots)
+	mark(tree.Orphans)
+	for _, node := range nodes {
+		if reachable[node] {
+			continue
+		}
+		tree.detach(node)
+		tree.Orphans = append(tree.Orphans, node)
+		mark([]*BranchNode{node})
+	}
+
+	return tree
+}
+
+func headBranchID(head *Ref) string {
+	if head == nil {
+		return ""
+	}
+	return head.ID
+}
+
+func (t *BranchTree) detach(node *BranchNode) {
+	parent := node.Parent
+	if parent == nil {
+		return
+	}
+	for i, child := range parent.Children {
+		if child == node {
+			parent.Children = append(parent.Children[:i], parent.Children[i+1:]...)
+			break
+		}
+	}
+	node.Parent = nil
+}
+
+// ByID looks up a branch node by Topdesk ID.
+func (t *BranchTree) ByID(id string) (*BranchNode, bool) {
+	node, ok := t.byID[id]
+	return node, ok
+}
+
+// ByName looks up branch nodes by name, names aren't unique in Topdesk.
+func (t *BranchTree) ByName(name string) []*BranchNode {
+	return t.byName[name]
+}
+
+// ByClientReferenceNumber looks up a branch node by client reference number.
+func (t *BranchTree) ByClientReferenceNumber(number string) (*BranchNode, bool) {
+	node, ok := t.byClientReferenceNumber[number]
+	return node, ok
+}
+
+// BranchTree loads all branches and builds the hierarchy.
+func (rc RestClient) BranchTree(ctx context.Context) (*BranchTree, error) {
+	it, err := rc.ListBranches(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "branch tree")
+	}
+
+	branches := []*Branch{}
+	for it.Next() {
+		branch, err := it.Branch()
+		if err != nil {
+			return nil, errors.Wrap(err, "branch tree")
+		}
+		branches = append(branches, branch)
+	}
+	if err := it.Err(); err != nil {
+		return nil, errors.Wrap(err, "branch tree")
+	}
+	return NewBranchTree(branches), nil
+}
@@ -0,0 +1,147 @@
+package topdesk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testBranch(id, name, head string) *Branch {
+	branch := &Branch{ID: id, Name: name}
+	if head != "" {
+		branch.HeadBranch = &Ref{ID: head}
+	}
+	return branch
+}
+
+func branchIDs(nodes []*BranchNode) []string {
+	ids := []string{}
+	for _, node := range nodes {
+		ids = append(ids, node.Branch.ID)
+	}
+	return ids
+}
+
+func TestNewBranchTree(t *testing.T) {
+	tests := []struct {
+		name     string
+		branches []*Branch
+		roots    []string
+		orphans  []string
+		parents  map[string]string // Branch ID to parent ID, empty for none.
+	}{
+		{
+			name: "hierarchy",
+			branches: []*Branch{
+				testBranch("c", "Child", "r"),
+				testBranch("r", "Root", ""),
+				testBranch("g", "Grandchild", "c"),
+			},
+			roots:   []string{"r"},
+			orphans: []string{},
+			parents: map[string]string{"r": "", "c": "r", "g": "c"},
+		},
+		{
+			name: "multiple roots",
+			branches: []*Branch{
+				testBranch("b", "Bravo", ""),
+				testBranch("a", "Alpha", ""),
+				testBranch("s", "Self", "s"),
+			},
+			roots:   []string{"a", "b", "s"},
+			orphans: []string{},
+			parents: map[string]string{"a": "", "b": "", "s": ""},
+		},
+		{
+			name: "missing parent",
+			branches: []*Branch{
+				testBranch("r", "Root", ""),
+				testBranch("o", "Orphan", "archived"),
+				testBranch("c", "Child of orphan", "o"),
+			},
+			roots:   []string{"r"},
+			orphans: []string{"o"},
+			parents: map[string]string{"r": "", "o": "", "c": "o"},
+		},
+		{
+			name: "cycle",
+			branches: []*Branch{
+				testBranch("r", "Root", ""),
+				testBranch("x", "X", "y"),
+				testBranch("y", "Y", "x"),
+				testBranch("z", "Z", "y"),
+			},
+			roots:   []string{"r"},
+			orphans: []string{"x"},
+			parents: map[string]string{"r": "", "x": "", "y": "x", "z": "y"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tree := NewBranchTree(test.branches)
+			if got := branchIDs(tree.Roots); !reflect.DeepEqual(got, test.roots) {
+				t.Errorf("roots got %v, want %v", got, test.roots)
+			}
+			if got := branchIDs(tree.Orphans); !reflect.DeepEqual(got, test.orphans) {
+				t.Errorf("orphans got %v, want %v", got, test.orphans)
+			}
+			for id, want := range test.parents {
+				node, ok := tree.ByID(id)
+				if !ok {
+					t.Fatalf("ByID(%s) not found", id)
+				}
+				got := ""
+				if node.Parent != nil {
+					got = node.Parent.Branch.ID
+				}
+				if got != want {
+					t.Errorf("%s parent got %q, want %q", id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBranchNodeWalk(t *testing.T) {
+	tree := NewBranchTree([]*Branch{
+		testBranch("r", "Root", ""),
+		testBranch("a", "A", "r"),
+		testBranch("b", "B", "r"),
+		testBranch("a1", "A1", "a"),
+	})
+
+	a1, _ := tree.ByID("a1")
+	if got := a1.Depth(); got != 2 {
+		t.Errorf("depth got %d, want 2", got)
+	}
+	if got := branchIDs(a1.Ancestors()); !reflect.DeepEqual(got, []string{"a", "r"}) {
+		t.Errorf("ancestors got %v", got)
+	}
+	if got := a1.Root().Branch.ID; got != "r" {
+		t.Errorf("root got %s", got)
+	}
+
+	root, _ := tree.ByID("r")
+	if got := branchIDs(root.Descendants()); !reflect.DeepEqual(got, []string{"a", "a1", "b"}) {
+		t.Errorf("descendants got %v", got)
+	}
+	if got := root.Depth(); got != 0 {
+		t.Errorf("root depth got %d", got)
+	}
+}
+
+func TestBranchTreeLookups(t *testing.T) {
+	first := testBranch("1", "Office", "")
+	first.ClientReferenceNumber = "CRN1"
+	tree := NewBranchTree([]*Branch{first, testBranch("2", "Office", "1")})
+
+	if got := branchIDs(tree.ByName("Office")); !reflect.DeepEqual(got, []string{"1", "2"}) {
+		t.Errorf("ByName got %v, want both branches", got)
+	}
+	if got := tree.ByName("Missing"); len(got) != 0 {
+		t.Errorf("ByName(Missing) got %v", got)
+	}
+	if node, ok := tree.ByClientReferenceNumber("CRN1"); !ok || node.Branch.ID != "1" {
+		t.Errorf("ByClientReferenceNumber got %v, %t", node, ok)
+	}
+}
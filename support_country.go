@@ -3,6 +3,10 @@ package topdesk
 import (
 	"context"
 	"path"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
 )
 
 type CountryIterator struct {
@@ -33,3 +37,162 @@ func (rc RestClient) ListCountries(ctx context.Context, request *ListCountriesRe
 	it, err := rc.list(ctx, &uri)
 	return &CountryIterator{it}, err
 }
+
+// CountryDirectory looks up Topdesk countries by ID, name or ISO 3166-1 code.
+//
+// Topdesk countries only have a localised name so ISO codes are matched on the English short name or a known
+// alias, use Map for anything else.
+type CountryDirectory struct {
+	byID   map[string]*Country
+	byName map[string]*Country
+	byISO  map[string]*Country
+	iso    map[string]ISOCountry
+}
+
+// NewCountryDirectory from a list of Topdesk countries.
+func NewCountryDirectory(countries []*Country) *CountryDirectory {
+	d := &CountryDirectory{
+		byID:   map[string]*Country{},
+		byName: map[string]*Country{},
+		byISO:  map[string]*Country{},
+		iso:    map[string]ISOCountry{},
+	}
+
+	codes := map[string]string{}
+	for _, iso := range ISOCountries {
+		codes[countryKey(iso.Name)] = iso.Alpha2
+	}
+	for name, alpha2 := range isoCountryAliases {
+		codes[countryKey(name)] = alpha2
+	}
+
+	for _, country := range countries {
+		d.byID[country.ID] = country
+		d.byName[countryKey(country.Name)] = country
+		if alpha2, ok := codes[countryKey(country.Name)]; ok {
+			d.Map(alpha2, country.ID)
+		}
+	}
+	return d
+}
+
+func countryKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func isoCountry(code string) (ISOCountry, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	for _, iso := range ISOCountries {
+		if iso.Alpha2 == code || iso.Alpha3 == code {
+			return iso, true
+		}
+	}
+	return ISOCountry{}, false
+}
+
+// Map an ISO alpha-2 or alpha-3 code to a Topdesk country ID, overriding any name match.
+func (d *CountryDirectory) Map(code string, id string) error {
+	iso, ok := isoCountry(code)
+	if !ok {
+		return errors.Errorf("map country: unknown iso code %s", code)
+	}
+	country, ok := d.byID[id]
+	if !ok {
+		return NotFoundError{Resource: "country", Query: "id " + id}
+	}
+
+	if previous, ok := d.byISO[iso.Alpha2]; ok {
+		delete(d.iso, previous.ID)
+	}
+	d.byISO[iso.Alpha2] = country
+	d.iso[country.ID] = iso
+	return nil
+}
+
+// ByID looks up a country by Topdesk ID.
+func (d *CountryDirectory) ByID(id string) (*Country, bool) {
+	country, ok := d.byID[id]
+	return country, ok
+}
+
+// ByName looks up a country by its Topdesk (localised) name, case insensitive.
+func (d *CountryDirectory) ByName(name string) (*Country, bool) {
+	country, ok := d.byName[countryKey(name)]
+	return country, ok
+}
+
+// ByISO looks up a country by ISO 3166-1 alpha-2 or alpha-3 code.
+func (d *CountryDirectory) ByISO(code string) (*Country, bool) {
+	iso, ok := isoCountry(code)
+	if !ok {
+		return nil, false
+	}
+	country, ok := d.byISO[iso.Alpha2]
+	return country, ok
+}
+
+// ISORef returns a country ref for an ISO 3166-1 code, e.g. for BranchAddressRequest.Country.
+func (d *CountryDirectory) ISORef(code string) (*Ref, error) {
+	country, ok := d.ByISO(code)
+	if !ok {
+		return nil, NotFoundError{Resource: "country", Query: "iso " + code}
+	}
+	return country.Ref(), nil
+}
+
+// ISO code for a Topdesk country ID.
+func (d *CountryDirectory) ISO(id string) (ISOCountry, bool) {
+	iso, ok := d.iso[id]
+	return iso, ok
+}
+
+// countryCache holds the countries listed by a client, they're effectively static.
+type countryCache struct {
+	mu        sync.Mutex
+	countries []*Country
+}
+
+// CountryDirectory of all countries.
+//
+// Countries are listed on first use and cached for the life of the client. Each call returns a new directory so
+// Map on one doesn't change another.
+func (rc RestClient) CountryDirectory(ctx context.Context) (*CountryDirectory, error) {
+	if rc.countries == nil {
+		countries, err := rc.listAllCountries(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return NewCountryDirectory(countries), nil
+	}
+
+	rc.countries.mu.Lock()
+	defer rc.countries.mu.Unlock()
+	if rc.countries.countries == nil {
+		countries, err := rc.listAllCountries(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rc.countries.countries = countries
+	}
+	return NewCountryDirectory(rc.countries.countries), nil
+}
+
+func (rc RestClient) listAllCountries(ctx context.Context) ([]*Country, error) {
+	it, err := rc.ListCountries(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "country directory")
+	}
+
+	countries := []*Country{}
+	for it.Next() {
+		country, err := it.Country()
+		if err != nil {
+			return nil, errors.Wrap(err, "country directory")
+		}
+		countries = append(countries, country)
+	}
+	if err := it.Err(); err != nil {
+		return nil, errors.Wrap(err, "country directory")
+	}
+	return countries, nil
+}
@@ -0,0 +1,115 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func testCountries() []*Country {
+	return []*Country{
+		{ID: "nl", Name: "Nederland"},
+		{ID: "gb", Name: "United  Kingdom"},
+		{ID: "cz", Name: "Czech Republic"},
+		{ID: "xx", Name: "Atlantis"},
+	}
+}
+
+func TestCountryDirectoryByISO(t *testing.T) {
+	d := NewCountryDirectory(testCountries())
+
+	tests := []struct {
+		code string
+		want string
+	}{
+		{code: "NL", want: "nl"},
+		{code: "nld", want: "nl"},
+		{code: " gb ", want: "gb"},
+		{code: "CZE", want: "cz"},
+		{code: "DE", want: ""},
+		{code: "ZZ", want: ""},
+	}
+
+	for _, test := range tests {
+		got := ""
+		if country, ok := d.ByISO(test.code); ok {
+			got = country.ID
+		}
+		if got != test.want {
+			t.Errorf("ByISO(%q) got %q, want %q", test.code, got, test.want)
+		}
+	}
+
+	if iso, ok := d.ISO("gb"); !ok || iso.Alpha3 != "GBR" {
+		t.Errorf("ISO(gb) got %+v, %t", iso, ok)
+	}
+	if _, ok := d.ISO("xx"); ok {
+		t.Error("ISO(xx) got a code for an unknown country")
+	}
+	if country, ok := d.ByName("nederland"); !ok || country.ID != "nl" {
+		t.Errorf("ByName(nederland) got %+v, %t", country, ok)
+	}
+}
+
+func TestCountryDirectoryISORef(t *testing.T) {
+	d := NewCountryDirectory(testCountries())
+
+	ref, err := d.ISORef("CZ")
+	if err != nil || ref.ID != "cz" {
+		t.Errorf("ISORef(CZ) got %+v, %v", ref, err)
+	}
+	if _, err := d.ISORef("DE"); err == nil {
+		t.Error("ISORef(DE) got nil error")
+	} else if _, ok := err.(NotFoundError); !ok {
+		t.Errorf("ISORef(DE) got %T, want NotFoundError", err)
+	}
+}
+
+func TestCountryDirectoryMap(t *testing.T) {
+	d := NewCountryDirectory(testCountries())
+
+	if err := d.Map("GB", "xx"); err != nil {
+		t.Fatal(err)
+	}
+	if country, _ := d.ByISO("GB"); country.ID != "xx" {
+		t.Errorf("ByISO(GB) after Map got %s, want xx", country.ID)
+	}
+	if _, ok := d.ISO("gb"); ok {
+		t.Error("ISO(gb) still mapped after override")
+	}
+	if err := d.Map("ZZ", "xx"); err == nil {
+		t.Error("Map unknown code got nil error")
+	}
+	if err := d.Map("DE", "missing"); err == nil {
+		t.Error("Map unknown id got nil error")
+	}
+}
+
+func TestRestClientCountryDirectoryCached(t *testing.T) {
+	requests := 0
+	client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(testCountries())
+	})
+	defer server.Close()
+
+	first, err := client.CountryDirectory(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := first.Map("GB", "xx"); err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := client.CountryDirectory(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Errorf("got %d list requests, want 1", requests)
+	}
+	if country, _ := second.ByISO("GB"); country.ID != "gb" {
+		t.Errorf("Map leaked between directories, ByISO(GB) got %s", country.ID)
+	}
+}
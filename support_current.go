@@ -0,0 +1,227 @@
+package topdesk
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func (rc RestClient) CurrentOperator(ctx context.Context) (*Operator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operators", "current")
+
+	response := &Operator{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+func (rc RestClient) CurrentPerson(ctx context.Context) (*Person, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persons", "current")
+
+	response := &Person{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// OperatorFilter limits the records an operator can see.
+type OperatorFilter struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type OperatorFilterType string
+
+const (
+	OperatorFilterBranch   OperatorFilterType = "branch"
+	OperatorFilterCategory OperatorFilterType = "category"
+	OperatorFilterOperator OperatorFilterType = "operator"
+)
+
+func (rc RestClient) ListOperatorFilters(ctx context.Context, id string, filter OperatorFilterType) ([]OperatorFilter, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operators", "id", id, "filters", string(filter))
+
+	response := []OperatorFilter{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// PermissionRight on a module.
+type PermissionRight string
+
+const (
+	PermissionRead    PermissionRight = "read"
+	PermissionWrite   PermissionRight = "write"
+	PermissionCreate  PermissionRight = "create"
+	PermissionArchive PermissionRight = "archive"
+)
+
+// PermissionRights granted on a module.
+type PermissionRights struct {
+	Read    bool `json:"read"`
+	Write   bool `json:"write"`
+	Create  bool `json:"create"`
+	Archive bool `json:"archive"`
+}
+
+// Has right, false for unknown rights.
+func (r PermissionRights) Has(right PermissionRight) bool {
+	switch right {
+	case PermissionRead:
+		return r.Read
+	case PermissionWrite:
+		return r.Write
+	case PermissionCreate:
+		return r.Create
+	case PermissionArchive:
+		return r.Archive
+	}
+	return false
+}
+
+// PermissionGroup grants module rights (read, write, archive etc.) to operators.
+//
+// Rights is keyed by module e.g. incidentManagement. Groups listed for an operator only have an ID and name, use
+// GetPermissionGroup for the rights.
+type PermissionGroup struct {
+	ID     string                      `json:"id"`
+	Name   string                      `json:"name"`
+	Rights map[string]PermissionRights `json:"rights,omitempty"`
+}
+
+func (rc RestClient) GetPermissionGroup(ctx context.Context, id string) (*PermissionGroup, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "permissiongroups", "id", id)
+
+	response := &PermissionGroup{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+func (rc RestClient) ListOperatorPermissionGroups(ctx context.Context, id string) ([]PermissionGroup, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operators", "id", id, "permissiongroups")
+
+	response := []PermissionGroup{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Identity the client is authenticated as.
+//
+// Application passwords belong to either an operator or a person. Roles, filters and permission groups (with their
+// rights) are only populated for operators.
+type Identity struct {
+	Operator         *Operator
+	Person           *Person
+	Roles            OperatorRoleSet
+	BranchFilters    []OperatorFilter
+	CategoryFilters  []OperatorFilter
+	OperatorFilters  []OperatorFilter
+	PermissionGroups []PermissionGroup
+}
+
+// Require returns an error naming any roles the identity is missing.
+func (i Identity) Require(roles ...OperatorRole) error {
+	if i.Operator == nil {
+		return errors.New("require roles: not authenticated as an operator")
+	}
+
+	missing := []string{}
+	for _, role := range roles {
+		if !i.Roles.Has(role) {
+			missing = append(missing, string(role))
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("require roles: operator %s missing %s", i.Operator.LoginName, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Rights on a module granted by any of the permission groups.
+func (i Identity) Rights(module string) PermissionRights {
+	rights := PermissionRights{}
+	for _, group := range i.PermissionGroups {
+		granted := group.Rights[module]
+		rights.Read = rights.Read || granted.Read
+		rights.Write = rights.Write || granted.Write
+		rights.Create = rights.Create || granted.Create
+		rights.Archive = rights.Archive || granted.Archive
+	}
+	return rights
+}
+
+// RequirePermission returns an error naming any rights on the module the identity is missing.
+func (i Identity) RequirePermission(module string, rights ...PermissionRight) error {
+	if i.Operator == nil {
+		return errors.New("require permission: not authenticated as an operator")
+	}
+
+	granted := i.Rights(module)
+	missing := []string{}
+	for _, right := range rights {
+		if !granted.Has(right) {
+			missing = append(missing, string(right))
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("require permission: operator %s missing %s %s", i.Operator.LoginName, module, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// CurrentIdentity returns the operator or person the client is authenticated as with its permissions.
+//
+// Persons are only tried when the operator endpoint refuses the client (401, 403 or 404), any other error is
+// returned.
+func (rc RestClient) CurrentIdentity(ctx context.Context) (*Identity, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "operators", "current")
+
+	operator := &Operator{}
+	status, err := rc.do(ctx, http.MethodGet, &uri, nil, operator)
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden || status == http.StatusNotFound:
+		person, perr := rc.CurrentPerson(ctx)
+		if perr != nil {
+			return nil, errors.Wrap(perr, "current identity")
+		}
+		return &Identity{Person: person, Roles: OperatorRoleSet{}}, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "current identity")
+	case status != http.StatusOK:
+		return nil, errors.Errorf("current identity: %s GET %s", http.StatusText(status), uri.String())
+	}
+
+	identity := &Identity{Operator: operator, Roles: operator.Roles()}
+	if identity.BranchFilters, err = rc.ListOperatorFilters(ctx, operator.ID, OperatorFilterBranch); err != nil {
+		return nil, errors.Wrap(err, "current identity branch filters")
+	}
+	if identity.CategoryFilters, err = rc.ListOperatorFilters(ctx, operator.ID, OperatorFilterCategory); err != nil {
+		return nil, errors.Wrap(err, "current identity category filters")
+	}
+	if identity.OperatorFilters, err = rc.ListOperatorFilters(ctx, operator.ID, OperatorFilterOperator); err != nil {
+		return nil, errors.Wrap(err, "current identity operator filters")
+	}
+	groups, err := rc.ListOperatorPermissionGroups(ctx, operator.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "current identity permission groups")
+	}
+	for _, group := range groups {
+		full, err := rc.GetPermissionGroup(ctx, group.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "current identity permission group %s", group.ID)
+		}
+		identity.PermissionGroups = append(identity.PermissionGroups, *full)
+	}
+	return identity, nil
+}
@@ -0,0 +1,125 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestIdentityRequirePermission(t *testing.T) {
+	identity := Identity{
+		Operator: &Operator{LoginName: "apple"},
+		PermissionGroups: []PermissionGroup{
+			{Rights: map[string]PermissionRights{"incidentManagement": {Read: true}}},
+			{Rights: map[string]PermissionRights{"incidentManagement": {Write: true}, "changeManagement": {Read: true}}},
+		},
+	}
+
+	tests := []struct {
+		module string
+		rights []PermissionRight
+		errors bool
+	}{
+		{module: "incidentManagement", rights: []PermissionRight{PermissionRead, PermissionWrite}},
+		{module: "incidentManagement", rights: []PermissionRight{PermissionArchive}, errors: true},
+		{module: "changeManagement", rights: []PermissionRight{PermissionRead}},
+		{module: "changeManagement", rights: []PermissionRight{PermissionWrite}, errors: true},
+		{module: "assetManagement", rights: []PermissionRight{PermissionRead}, errors: true},
+		{module: "incidentManagement", rights: []PermissionRight{"delete"}, errors: true},
+	}
+
+	for _, test := range tests {
+		err := identity.RequirePermission(test.module, test.rights...)
+		if (err != nil) != test.errors {
+			t.Errorf("%s %v got error %v, want error %t", test.module, test.rights, err, test.errors)
+		}
+	}
+
+	if err := (Identity{Person: &Person{}}).RequirePermission("incidentManagement", PermissionRead); err == nil {
+		t.Error("person identity got nil error")
+	}
+}
+
+func TestCurrentIdentityFallback(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		person bool
+		errors bool
+	}{
+		{name: "forbidden", status: http.StatusForbidden, body: `[{"message":"no"}]`, person: true},
+		{name: "unauthorized empty body", status: http.StatusUnauthorized, person: true},
+		{name: "not found", status: http.StatusNotFound, body: `[]`, person: true},
+		{name: "server error", status: http.StatusInternalServerError, body: `[{"message":"boom"}]`, errors: true},
+		{name: "unavailable", status: http.StatusServiceUnavailable, errors: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			persons := 0
+			client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/tas/api/operators/current":
+					w.WriteHeader(test.status)
+					w.Write([]byte(test.body))
+				case "/tas/api/persons/current":
+					persons++
+					json.NewEncoder(w).Encode(Person{ID: "p1"})
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+			defer server.Close()
+
+			identity, err := client.CurrentIdentity(context.Background())
+			if test.errors {
+				if err == nil {
+					t.Fatalf("got %+v, want error", identity)
+				}
+				if persons != 0 {
+					t.Error("fell back to the person endpoint")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if identity.Person == nil || identity.Person.ID != "p1" || identity.Operator != nil {
+				t.Errorf("got %+v, want person p1", identity)
+			}
+		})
+	}
+}
+
+func TestCurrentIdentityOperator(t *testing.T) {
+	client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tas/api/operators/current":
+			json.NewEncoder(w).Encode(Operator{ID: "o1", LoginName: "apple", Installer: true})
+		case "/tas/api/operators/id/o1/permissiongroups":
+			json.NewEncoder(w).Encode([]PermissionGroup{{ID: "g1", Name: "Operators"}})
+		case "/tas/api/permissiongroups/id/g1":
+			json.NewEncoder(w).Encode(PermissionGroup{
+				ID:     "g1",
+				Name:   "Operators",
+				Rights: map[string]PermissionRights{"incidentManagement": {Read: true, Write: true}},
+			})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	defer server.Close()
+
+	identity, err := client.CurrentIdentity(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := identity.Require(OperatorRoleInstaller); err != nil {
+		t.Error(err)
+	}
+	if err := identity.RequirePermission("incidentManagement", PermissionRead, PermissionWrite); err != nil {
+		t.Error(err)
+	}
+}
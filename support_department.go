@@ -0,0 +1,64 @@
+package topdesk
+
+import (
+	"context"
+	"path"
+)
+
+type DepartmentIterator struct {
+	*ListIterator
+}
+
+func (i DepartmentIterator) Department() (*Department, error) {
+	response := &Department{}
+	err := i.decode(&response)
+	return response, err
+}
+
+type Department struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (d Department) Ref() *Ref {
+	return &Ref{ID: d.ID}
+}
+
+type ListDepartmentsRequest struct{}
+
+func (rc RestClient) ListDepartments(ctx context.Context, request *ListDepartmentsRequest) (*DepartmentIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "departments")
+
+	it, err := rc.list(ctx, &uri)
+	return &DepartmentIterator{it}, err
+}
+
+type BudgetHolderIterator struct {
+	*ListIterator
+}
+
+func (i BudgetHolderIterator) BudgetHolder() (*BudgetHolder, error) {
+	response := &BudgetHolder{}
+	err := i.decode(&response)
+	return response, err
+}
+
+type BudgetHolder struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (b BudgetHolder) Ref() *Ref {
+	return &Ref{ID: b.ID}
+}
+
+type ListBudgetHoldersRequest struct{}
+
+func (rc RestClient) ListBudgetHolders(ctx context.Context, request *ListBudgetHoldersRequest) (*BudgetHolderIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "budgetholders")
+
+	it, err := rc.list(ctx, &uri)
+	return &BudgetHolderIterator{it}, err
+}
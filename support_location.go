@@ -3,6 +3,7 @@ package topdesk
 import (
 	"context"
 	"path"
+	"strconv"
 )
 
 type LocationIterator struct {
@@ -39,18 +40,50 @@ type Location struct {
 		ID   string `json:"id"`
 		Name string `json:"name"`
 	} `json:"branch"`
+	BuildingZone struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"buildingZone"`
+	Archived bool `json:"archived"`
 }
 
 func (l Location) Ref() *Ref {
 	return &Ref{ID: l.ID}
 }
 
-type ListLocationsRequest struct{}
+// ListLocationsRequest filters locations, empty fields are ignored.
+//
+// Query is a FIQL expression and'ed with the other filters.
+type ListLocationsRequest struct {
+	BranchID       string
+	Name           string
+	TypeID         string
+	BuildingZoneID string
+	Archived       *bool
+	Query          string
+}
 
 func (rc RestClient) ListLocations(ctx context.Context, request *ListLocationsRequest) (*LocationIterator, error) {
 	uri := *rc.endpoint
 	uri.Path = path.Join(uri.Path, "locations")
 
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.
+			eq("branch.id", request.BranchID).
+			eq("name", request.Name).
+			eq("type.id", request.TypeID).
+			eq("buildingZone.id", request.BuildingZoneID).
+			and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		if request.Archived != nil {
+			query.Set("archived", strconv.FormatBool(*request.Archived))
+		}
+		uri.RawQuery = query.Encode()
+	}
+
 	it, err := rc.list(ctx, &uri)
 	return &LocationIterator{it}, err
 }
@@ -63,3 +96,122 @@ func (rc RestClient) GetLocation(ctx context.Context, id string) (*Location, err
 	err := rc.get(ctx, &uri, response)
 	return response, err
 }
+
+// CreateLocationRequest creates a location, Name and Branch are required.
+//
+// See https://developers.topdesk.com/explorer/?page=supporting-files#/Locations/createLocation
+type CreateLocationRequest struct {
+	Name          string `json:"name"`
+	Branch        *Ref   `json:"branch"`
+	RoomNumber    string `json:"roomNumber,omitempty"`
+	FunctionalUse *Ref   `json:"functionalUse,omitempty"`
+	Type          *Ref   `json:"type,omitempty"`
+	BuildingZone  *Ref   `json:"buildingZone,omitempty"`
+	Capacity      int    `json:"capacity,omitempty"`
+	Specification string `json:"specification,omitempty"`
+	BudgetHolder  *Ref   `json:"budgetHolder,omitempty"`
+}
+
+func (rc RestClient) CreateLocation(ctx context.Context, request *CreateLocationRequest) (*Location, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "locations")
+
+	response := &Location{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateLocationRequest patches a location, only non-zero fields are sent.
+type UpdateLocationRequest struct {
+	ID            string `json:"-"`
+	Name          string `json:"name,omitempty"`
+	Branch        *Ref   `json:"branch,omitempty"`
+	RoomNumber    string `json:"roomNumber,omitempty"`
+	FunctionalUse *Ref   `json:"functionalUse,omitempty"`
+	Type          *Ref   `json:"type,omitempty"`
+	BuildingZone  *Ref   `json:"buildingZone,omitempty"`
+	Capacity      *int   `json:"capacity,omitempty"`
+	Specification string `json:"specification,omitempty"`
+	BudgetHolder  *Ref   `json:"budgetHolder,omitempty"`
+}
+
+func (rc RestClient) UpdateLocation(ctx context.Context, request *UpdateLocationRequest) (*Location, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "locations", "id", request.ID)
+
+	response := &Location{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ArchiveLocationRequest archives a location, Reason is an ArchivingReason ref.
+type ArchiveLocationRequest struct {
+	ID     string
+	Reason *Ref
+}
+
+func (rc RestClient) ArchiveLocation(ctx context.Context, request *ArchiveLocationRequest) (*Location, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "locations", "id", request.ID, "archive")
+
+	response := &Location{}
+	if err := rc.patch(ctx, &uri, request.Reason, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (rc RestClient) UnarchiveLocation(ctx context.Context, id string) (*Location, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "locations", "id", id, "unarchive")
+
+	response := &Location{}
+	if err := rc.patch(ctx, &uri, nil, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// LocationLookup is an entry in one of the location lookup lists.
+type LocationLookup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (l LocationLookup) Ref() *Ref {
+	return &Ref{ID: l.ID}
+}
+
+func (rc RestClient) listLocationLookups(ctx context.Context, name string) ([]LocationLookup, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "locations", name)
+
+	response := []LocationLookup{}
+	if err := rc.getAll(ctx, &uri, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListLocationFunctionalUses for Location.FunctionalUse.
+func (rc RestClient) ListLocationFunctionalUses(ctx context.Context) ([]LocationLookup, error) {
+	return rc.listLocationLookups(ctx, "functional_uses")
+}
+
+// ListLocationTypes for Location.Type.
+func (rc RestClient) ListLocationTypes(ctx context.Context) ([]LocationLookup, error) {
+	return rc.listLocationLookups(ctx, "types")
+}
+
+// ListBuildingZones for Location.BuildingZone.
+func (rc RestClient) ListBuildingZones(ctx context.Context) ([]LocationLookup, error) {
+	return rc.listLocationLookups(ctx, "building_zones")
+}
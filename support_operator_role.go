@@ -0,0 +1,294 @@
+package topdesk
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// OperatorRole is an operator permission flag, the value is the Topdesk field name.
+type OperatorRole string
+
+const (
+	OperatorRoleInstaller                OperatorRole = "installer"
+	OperatorRoleFirstLineCallOperator    OperatorRole = "firstLineCallOperator"
+	OperatorRoleSecondLineCallOperator   OperatorRole = "secondLineCallOperator"
+	OperatorRoleProblemManager           OperatorRole = "problemManager"
+	OperatorRoleProblemOperator          OperatorRole = "problemOperator"
+	OperatorRoleChangeCoordinator        OperatorRole = "changeCoordinator"
+	OperatorRoleChangeActivitiesOperator OperatorRole = "changeActivitiesOperator"
+	OperatorRoleRequestForChangeOperator OperatorRole = "requestForChangeOperator"
+	OperatorRoleExtensiveChangeOperator  OperatorRole = "extensiveChangeOperator"
+	OperatorRoleSimpleChangeOperator     OperatorRole = "simpleChangeOperator"
+	OperatorRoleScenarioManager          OperatorRole = "scenarioManager"
+	OperatorRolePlanningActivityManager  OperatorRole = "planningActivityManager"
+	OperatorRoleProjectCoordinator       OperatorRole = "projectCoordinator"
+	OperatorRoleProjectActiviesOperator  OperatorRole = "projectActiviesOperator"
+	OperatorRoleStockManager             OperatorRole = "stockManager"
+	OperatorRoleReservationsOperator     OperatorRole = "reservationsOperator"
+	OperatorRoleServiceOperator          OperatorRole = "serviceOperator"
+	OperatorRoleExternalHelpDeskParty    OperatorRole = "externalHelpDeskParty"
+	OperatorRoleContractManager          OperatorRole = "contractManager"
+	OperatorRoleOperationsOperator       OperatorRole = "operationsOperator"
+	OperatorRoleOperationsManager        OperatorRole = "operationsManager"
+	OperatorRoleKnowledgeBaseManager     OperatorRole = "knowledgeBaseManager"
+	OperatorRoleAccountManager           OperatorRole = "accountManager"
+)
+
+// AllOperatorRoles in Topdesk field order.
+var AllOperatorRoles = []OperatorRole{
+	OperatorRoleInstaller,
+	OperatorRoleFirstLineCallOperator,
+	OperatorRoleSecondLineCallOperator,
+	OperatorRoleProblemManager,
+	OperatorRoleProblemOperator,
+	OperatorRoleChangeCoordinator,
+	OperatorRoleChangeActivitiesOperator,
+	OperatorRoleRequestForChangeOperator,
+	OperatorRoleExtensiveChangeOperator,
+	OperatorRoleSimpleChangeOperator,
+	OperatorRoleScenarioManager,
+	OperatorRolePlanningActivityManager,
+	OperatorRoleProjectCoordinator,
+	OperatorRoleProjectActiviesOperator,
+	OperatorRoleStockManager,
+	OperatorRoleReservationsOperator,
+	OperatorRoleServiceOperator,
+	OperatorRoleExternalHelpDeskParty,
+	OperatorRoleContractManager,
+	OperatorRoleOperationsOperator,
+	OperatorRoleOperationsManager,
+	OperatorRoleKnowledgeBaseManager,
+	OperatorRoleAccountManager,
+}
+
+// OperatorRoleSet is a set of operator roles.
+type OperatorRoleSet map[OperatorRole]bool
+
+// Has role.
+func (s OperatorRoleSet) Has(role OperatorRole) bool {
+	return s[role]
+}
+
+// List roles in Topdesk field order.
+func (s OperatorRoleSet) List() []OperatorRole {
+	roles := []OperatorRole{}
+	for _, role := range AllOperatorRoles {
+		if s[role] {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// operatorRoleField accesses the field of a role on operators and requests.
+type operatorRoleField struct {
+	operator func(*Operator) *bool
+	create   func(*CreateOperatorRequest) *bool
+	update   func(*UpdateOperatorRequest) **bool
+}
+
+var operatorRoleFields = map[OperatorRole]operatorRoleField{
+	OperatorRoleInstaller: {
+		func(o *Operator) *bool { return &o.Installer },
+		func(r *CreateOperatorRequest) *bool { return &r.Installer },
+		func(r *UpdateOperatorRequest) **bool { return &r.Installer },
+	},
+	OperatorRoleFirstLineCallOperator: {
+		func(o *Operator) *bool { return &o.FirstLineCallOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.FirstLineCallOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.FirstLineCallOperator },
+	},
+	OperatorRoleSecondLineCallOperator: {
+		func(o *Operator) *bool { return &o.SecondLineCallOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.SecondLineCallOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.SecondLineCallOperator },
+	},
+	OperatorRoleProblemManager: {
+		func(o *Operator) *bool { return &o.ProblemManager },
+		func(r *CreateOperatorRequest) *bool { return &r.ProblemManager },
+		func(r *UpdateOperatorRequest) **bool { return &r.ProblemManager },
+	},
+	OperatorRoleProblemOperator: {
+		func(o *Operator) *bool { return &o.ProblemOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.ProblemOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.ProblemOperator },
+	},
+	OperatorRoleChangeCoordinator: {
+		func(o *Operator) *bool { return &o.ChangeCoordinator },
+		func(r *CreateOperatorRequest) *bool { return &r.ChangeCoordinator },
+		func(r *UpdateOperatorRequest) **bool { return &r.ChangeCoordinator },
+	},
+	OperatorRoleChangeActivitiesOperator: {
+		func(o *Operator) *bool { return &o.ChangeActivitiesOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.ChangeActivitiesOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.ChangeActivitiesOperator },
+	},
+	OperatorRoleRequestForChangeOperator: {
+		func(o *Operator) *bool { return &o.RequestForChangeOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.RequestForChangeOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.RequestForChangeOperator },
+	},
+	OperatorRoleExtensiveChangeOperator: {
+		func(o *Operator) *bool { return &o.ExtensiveChangeOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.ExtensiveChangeOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.ExtensiveChangeOperator },
+	},
+	OperatorRoleSimpleChangeOperator: {
+		func(o *Operator) *bool { return &o.SimpleChangeOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.SimpleChangeOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.SimpleChangeOperator },
+	},
+	OperatorRoleScenarioManager: {
+		func(o *Operator) *bool { return &o.ScenarioManager },
+		func(r *CreateOperatorRequest) *bool { return &r.ScenarioManager },
+		func(r *UpdateOperatorRequest) **bool { return &r.ScenarioManager },
+	},
+	OperatorRolePlanningActivityManager: {
+		func(o *Operator) *bool { return &o.PlanningActivityManager },
+		func(r *CreateOperatorRequest) *bool { return &r.PlanningActivityManager },
+		func(r *UpdateOperatorRequest) **bool { return &r.PlanningActivityManager },
+	},
+	OperatorRoleProjectCoordinator: {
+		func(o *Operator) *bool { return &o.ProjectCoordinator },
+		func(r *CreateOperatorRequest) *bool { return &r.ProjectCoordinator },
+		func(r *UpdateOperatorRequest) **bool { return &r.ProjectCoordinator },
+	},
+	OperatorRoleProjectActiviesOperator: {
+		func(o *Operator) *bool { return &o.ProjectActiviesOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.ProjectActiviesOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.ProjectActiviesOperator },
+	},
+	OperatorRoleStockManager: {
+		func(o *Operator) *bool { return &o.StockManager },
+		func(r *CreateOperatorRequest) *bool { return &r.StockManager },
+		func(r *UpdateOperatorRequest) **bool { return &r.StockManager },
+	},
+	OperatorRoleReservationsOperator: {
+		func(o *Operator) *bool { return &o.ReservationsOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.ReservationsOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.ReservationsOperator },
+	},
+	OperatorRoleServiceOperator: {
+		func(o *Operator) *bool { return &o.ServiceOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.ServiceOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.ServiceOperator },
+	},
+	OperatorRoleExternalHelpDeskParty: {
+		func(o *Operator) *bool { return &o.ExternalHelpDeskParty },
+		func(r *CreateOperatorRequest) *bool { return &r.ExternalHelpDeskParty },
+		func(r *UpdateOperatorRequest) **bool { return &r.ExternalHelpDeskParty },
+	},
+	OperatorRoleContractManager: {
+		func(o *Operator) *bool { return &o.ContractManager },
+		func(r *CreateOperatorRequest) *bool { return &r.ContractManager },
+		func(r *UpdateOperatorRequest) **bool { return &r.ContractManager },
+	},
+	OperatorRoleOperationsOperator: {
+		func(o *Operator) *bool { return &o.OperationsOperator },
+		func(r *CreateOperatorRequest) *bool { return &r.OperationsOperator },
+		func(r *UpdateOperatorRequest) **bool { return &r.OperationsOperator },
+	},
+	OperatorRoleOperationsManager: {
+		func(o *Operator) *bool { return &o.OperationsManager },
+		func(r *CreateOperatorRequest) *bool { return &r.OperationsManager },
+		func(r *UpdateOperatorRequest) **bool { return &r.OperationsManager },
+	},
+	OperatorRoleKnowledgeBaseManager: {
+		func(o *Operator) *bool { return &o.KnowledgeBaseManager },
+		func(r *CreateOperatorRequest) *bool { return &r.KnowledgeBaseManager },
+		func(r *UpdateOperatorRequest) **bool { return &r.KnowledgeBaseManager },
+	},
+	OperatorRoleAccountManager: {
+		func(o *Operator) *bool { return &o.AccountManager },
+		func(r *CreateOperatorRequest) *bool { return &r.AccountManager },
+		func(r *UpdateOperatorRequest) **bool { return &r.AccountManager },
+	},
+}
+
+// Valid is true for roles in AllOperatorRoles.
+func (r OperatorRole) Valid() bool {
+	_, ok := operatorRoleFields[r]
+	return ok
+}
+
+// validateOperatorRoles returns an error for the first unknown role.
+func validateOperatorRoles(roles []OperatorRole) error {
+	for _, role := range roles {
+		if !role.Valid() {
+			return errors.Errorf("unknown operator role %q", role)
+		}
+	}
+	return nil
+}
+
+// Roles granted to the operator.
+func (o Operator) Roles() OperatorRoleSet {
+	set := OperatorRoleSet{}
+	for _, role := range AllOperatorRoles {
+		if *operatorRoleFields[role].operator(&o) {
+			set[role] = true
+		}
+	}
+	return set
+}
+
+// HasRole is true if the operator has been granted the role.
+func (o Operator) HasRole(role OperatorRole) bool {
+	return o.Roles().Has(role)
+}
+
+// DiffOperatorRoles returns the roles granted and revoked going from operator a to operator b.
+func DiffOperatorRoles(a, b *Operator) (granted []OperatorRole, revoked []OperatorRole) {
+	from, to := a.Roles(), b.Roles()
+	for _, role := range AllOperatorRoles {
+		switch {
+		case !from[role] && to[role]:
+			granted = append(granted, role)
+		case from[role] && !to[role]:
+			revoked = append(revoked, role)
+		}
+	}
+	return granted, revoked
+}
+
+// GrantRoles sets the roles on a create request, nothing is set if any role is unknown.
+func (r *CreateOperatorRequest) GrantRoles(roles ...OperatorRole) error {
+	if err := validateOperatorRoles(roles); err != nil {
+		return errors.Wrap(err, "grant roles")
+	}
+	for _, role := range roles {
+		*operatorRoleFields[role].create(r) = true
+	}
+	return nil
+}
+
+// GrantRoles sets the roles on an update request, nothing is set if any role is unknown.
+func (r *UpdateOperatorRequest) GrantRoles(roles ...OperatorRole) error {
+	if err := validateOperatorRoles(roles); err != nil {
+		return errors.Wrap(err, "grant roles")
+	}
+	for _, role := range roles {
+		*operatorRoleFields[role].update(r) = Bool(true)
+	}
+	return nil
+}
+
+// RevokeRoles clears the roles on an update request, nothing is cleared if any role is unknown.
+func (r *UpdateOperatorRequest) RevokeRoles(roles ...OperatorRole) error {
+	if err := validateOperatorRoles(roles); err != nil {
+		return errors.Wrap(err, "revoke roles")
+	}
+	for _, role := range roles {
+		*operatorRoleFields[role].update(r) = Bool(false)
+	}
+	return nil
+}
+
+func operatorRolesQuery(roles []OperatorRole) string {
+	fiql := []string{}
+	for _, role := range roles {
+		fiql = append(fiql, string(role)+"==true")
+	}
+	return strings.Join(fiql, ";")
+}
@@ -0,0 +1,48 @@
+package topdesk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestOperatorRoleFields(t *testing.T) {
+	if len(operatorRoleFields) != len(AllOperatorRoles) {
+		t.Fatalf("got %d role fields, want %d", len(operatorRoleFields), len(AllOperatorRoles))
+	}
+
+	for _, role := range AllOperatorRoles {
+		create := &CreateOperatorRequest{}
+		if err := create.GrantRoles(role); err != nil {
+			t.Fatal(err)
+		}
+		update := &UpdateOperatorRequest{}
+		if err := update.GrantRoles(role); err != nil {
+			t.Fatal(err)
+		}
+
+		operator := Operator{}
+		*operatorRoleFields[role].operator(&operator) = true
+		if got := operator.Roles().List(); !reflect.DeepEqual(got, []OperatorRole{role}) {
+			t.Errorf("%s: got roles %v", role, got)
+		}
+	}
+}
+
+func TestOperatorRolesUnknown(t *testing.T) {
+	update := &UpdateOperatorRequest{}
+	if err := update.GrantRoles(OperatorRoleInstaller, "wizard"); err == nil {
+		t.Fatal("got nil error for unknown role")
+	}
+	if update.Installer != nil {
+		t.Error("known role set despite unknown role")
+	}
+
+	client, err := NewRestClient(context.Background(), "http://topdesk.invalid/tas/api", "user:token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListOperators(context.Background(), &ListOperatorsRequest{Roles: []OperatorRole{"wizard"}}); err == nil {
+		t.Error("list operators got nil error for unknown role")
+	}
+}
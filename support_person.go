@@ -3,6 +3,8 @@ package topdesk
 import (
 	"context"
 	"path"
+	"strconv"
+	"strings"
 )
 
 type PeopleIterator struct {
@@ -28,6 +30,10 @@ type Person struct {
 	NetworkLoginName            string `json:"networkLoginName,omitempty"`
 	Branch                      *Ref   `json:"branch,omitempty"`
 	Location                    *Ref   `json:"location,omitempty"`
+	Department                  *Ref   `json:"department,omitempty"`
+	BudgetHolder                *Ref   `json:"budgetHolder,omitempty"`
+	PersonExtraFieldA           *Ref   `json:"personExtraFieldA,omitempty"`
+	PersonExtraFieldB           *Ref   `json:"personExtraFieldB,omitempty"`
 	DepartmentFree              string `json:"departmentFree,omitempty"`
 	TasLoginName                string `json:"tasLoginName,omitempty"`
 	Password                    string `json:"password,omitempty"`
@@ -52,16 +58,74 @@ func (p Person) Ref() *Ref {
 	return &Ref{ID: p.ID}
 }
 
-type ListPeopleRequest struct{}
+// ListPeopleRequest filters people, empty fields are ignored.
+//
+// Query is a FIQL expression e.g. `surName==Arthurton,surName==Bosworth` and'ed with the other filters.
+// Sort is a list of `field:asc` or `field:desc` and Fields limits the fields returned for each person.
+type ListPeopleRequest struct {
+	Email            string
+	FirstName        string
+	Surname          string
+	EmployeeNumber   string
+	NetworkLoginName string
+	SSPLoginName     string
+	BranchID         string
+	Archived         *bool
+	Query            string
+	Sort             []string
+	Fields           []string
+}
 
 func (rc RestClient) ListPeople(ctx context.Context, request *ListPeopleRequest) (*PeopleIterator, error) {
 	uri := *rc.endpoint
 	uri.Path = path.Join(uri.Path, "persons")
 
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.
+			eq("email", request.Email).
+			eq("firstName", request.FirstName).
+			eq("surName", request.Surname).
+			eq("employeeNumber", request.EmployeeNumber).
+			eq("networkLoginName", request.NetworkLoginName).
+			eq("tasLoginName", request.SSPLoginName).
+			eq("branch.id", request.BranchID).
+			and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		if request.Archived != nil {
+			query.Set("archived", strconv.FormatBool(*request.Archived))
+		}
+		if len(request.Sort) > 0 {
+			query.Set("sort", strings.Join(request.Sort, ","))
+		}
+		if len(request.Fields) > 0 {
+			query.Set("fields", strings.Join(request.Fields, ","))
+		}
+
+		uri.RawQuery = query.Encode()
+	}
+
 	it, err := rc.list(ctx, &uri)
 	return &PeopleIterator{it}, err
 }
 
+// FindPersonByEmail returns the first person with the email address or a NotFoundError.
+func (rc RestClient) FindPersonByEmail(ctx context.Context, email string) (*Person, error) {
+	people, err := rc.ListPeople(ctx, &ListPeopleRequest{Email: email})
+	if err != nil {
+		return nil, err
+	}
+	if !people.Next() {
+		if err := people.Err(); err != nil {
+			return nil, err
+		}
+		return nil, NotFoundError{Resource: "person", Query: "email " + email}
+	}
+	return people.Person()
+}
+
 func (rc RestClient) GetPerson(ctx context.Context, id string) (*Person, error) {
 	uri := *rc.endpoint
 	uri.Path = path.Join(uri.Path, "persons", "id", id)
@@ -70,3 +134,134 @@ func (rc RestClient) GetPerson(ctx context.Context, id string) (*Person, error)
 	err := rc.get(ctx, &uri, response)
 	return response, err
 }
+
+// CreatePersonRequest creates a person.
+//
+// See https://developers.topdesk.com/explorer/?page=supporting-files#/Persons/createPerson
+type CreatePersonRequest struct {
+	Surname                     string `json:"surName"`
+	FirstName                   string `json:"firstName,omitempty"`
+	FirstInitials               string `json:"firstInitials,omitempty"`
+	Prefixes                    string `json:"prefixes,omitempty"`
+	Gender                      string `json:"gender,omitempty"`
+	EmployeeNumber              string `json:"employeeNumber,omitempty"`
+	ClientReferenceNumber       string `json:"clientReferenceNumber,omitempty"`
+	NetworkLoginName            string `json:"networkLoginName,omitempty"`
+	Branch                      *Ref   `json:"branch"`
+	Location                    *Ref   `json:"location,omitempty"`
+	Department                  *Ref   `json:"department,omitempty"`
+	BudgetHolder                *Ref   `json:"budgetHolder,omitempty"`
+	PersonExtraFieldA           *Ref   `json:"personExtraFieldA,omitempty"`
+	PersonExtraFieldB           *Ref   `json:"personExtraFieldB,omitempty"`
+	DepartmentFree              string `json:"departmentFree,omitempty"`
+	TasLoginName                string `json:"tasLoginName,omitempty"`
+	Password                    string `json:"password,omitempty"`
+	PhoneNumber                 string `json:"phoneNumber,omitempty"`
+	MobileNumber                string `json:"mobileNumber,omitempty"`
+	Fax                         string `json:"fax,omitempty"`
+	Email                       string `json:"email,omitempty"`
+	JobTitle                    string `json:"jobTitle,omitempty"`
+	ShowBudgetholder            bool   `json:"showBudgetholder,omitempty"`
+	ShowDepartment              bool   `json:"showDepartment,omitempty"`
+	ShowBranch                  bool   `json:"showBranch,omitempty"`
+	ShowSubsidiaries            bool   `json:"showSubsidiaries,omitempty"`
+	ShowAllBranches             bool   `json:"showAllBranches,omitempty"`
+	AuthorizeAll                bool   `json:"authorizeAll,omitempty"`
+	AuthorizeDepartment         bool   `json:"authorizeDepartment,omitempty"`
+	AuthorizeBudgetHolder       bool   `json:"authorizeBudgetHolder,omitempty"`
+	AuthorizeBranch             bool   `json:"authorizeBranch,omitempty"`
+	AuthorizeSubsidiaryBranches bool   `json:"authorizeSubsidiaryBranches,omitempty"`
+}
+
+func (rc RestClient) CreatePerson(ctx context.Context, request *CreatePersonRequest) (*Person, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persons")
+
+	response := &Person{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdatePersonRequest patches a person, only non-zero fields are sent.
+//
+// Visibility and authorization flags are pointers so they can be revoked, see Bool().
+type UpdatePersonRequest struct {
+	ID                          string `json:"-"`
+	Surname                     string `json:"surName,omitempty"`
+	FirstName                   string `json:"firstName,omitempty"`
+	FirstInitials               string `json:"firstInitials,omitempty"`
+	Prefixes                    string `json:"prefixes,omitempty"`
+	Gender                      string `json:"gender,omitempty"`
+	EmployeeNumber              string `json:"employeeNumber,omitempty"`
+	ClientReferenceNumber       string `json:"clientReferenceNumber,omitempty"`
+	NetworkLoginName            string `json:"networkLoginName,omitempty"`
+	Branch                      *Ref   `json:"branch,omitempty"`
+	Location                    *Ref   `json:"location,omitempty"`
+	Department                  *Ref   `json:"department,omitempty"`
+	BudgetHolder                *Ref   `json:"budgetHolder,omitempty"`
+	PersonExtraFieldA           *Ref   `json:"personExtraFieldA,omitempty"`
+	PersonExtraFieldB           *Ref   `json:"personExtraFieldB,omitempty"`
+	DepartmentFree              string `json:"departmentFree,omitempty"`
+	TasLoginName                string `json:"tasLoginName,omitempty"`
+	Password                    string `json:"password,omitempty"`
+	PhoneNumber                 string `json:"phoneNumber,omitempty"`
+	MobileNumber                string `json:"mobileNumber,omitempty"`
+	Fax                         string `json:"fax,omitempty"`
+	Email                       string `json:"email,omitempty"`
+	JobTitle                    string `json:"jobTitle,omitempty"`
+	ShowBudgetholder            *bool  `json:"showBudgetholder,omitempty"`
+	ShowDepartment              *bool  `json:"showDepartment,omitempty"`
+	ShowBranch                  *bool  `json:"showBranch,omitempty"`
+	ShowSubsidiaries            *bool  `json:"showSubsidiaries,omitempty"`
+	ShowAllBranches             *bool  `json:"showAllBranches,omitempty"`
+	AuthorizeAll                *bool  `json:"authorizeAll,omitempty"`
+	AuthorizeDepartment         *bool  `json:"authorizeDepartment,omitempty"`
+	AuthorizeBudgetHolder       *bool  `json:"authorizeBudgetHolder,omitempty"`
+	AuthorizeBranch             *bool  `json:"authorizeBranch,omitempty"`
+	AuthorizeSubsidiaryBranches *bool  `json:"authorizeSubsidiaryBranches,omitempty"`
+}
+
+func (rc RestClient) UpdatePerson(ctx context.Context, request *UpdatePersonRequest) (*Person, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persons", "id", request.ID)
+
+	response := &Person{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ArchivePersonRequest archives a person, Reason is an ArchivingReason ref.
+type ArchivePersonRequest struct {
+	ID     string
+	Reason *Ref
+}
+
+func (rc RestClient) ArchivePerson(ctx context.Context, request *ArchivePersonRequest) (*Person, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persons", "id", request.ID, "archive")
+
+	response := &Person{}
+	if err := rc.patch(ctx, &uri, request.Reason, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (rc RestClient) UnarchivePerson(ctx context.Context, id string) (*Person, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persons", "id", id, "unarchive")
+
+	response := &Person{}
+	if err := rc.patch(ctx, &uri, nil, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,44 @@
+package topdesk
+
+import (
+	"context"
+	"path"
+)
+
+type PersonExtraFieldEntryIterator struct {
+	*ListIterator
+}
+
+func (i PersonExtraFieldEntryIterator) PersonExtraFieldEntry() (*PersonExtraFieldEntry, error) {
+	response := &PersonExtraFieldEntry{}
+	err := i.decode(&response)
+	return response, err
+}
+
+// PersonExtraFieldEntry is a selectable value for Person.PersonExtraFieldA or Person.PersonExtraFieldB.
+type PersonExtraFieldEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (e PersonExtraFieldEntry) Ref() *Ref {
+	return &Ref{ID: e.ID}
+}
+
+type ListPersonExtraFieldEntriesRequest struct{}
+
+func (rc RestClient) ListPersonExtraFieldAEntries(ctx context.Context, request *ListPersonExtraFieldEntriesRequest) (*PersonExtraFieldEntryIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "personExtraFieldAEntries")
+
+	it, err := rc.list(ctx, &uri)
+	return &PersonExtraFieldEntryIterator{it}, err
+}
+
+func (rc RestClient) ListPersonExtraFieldBEntries(ctx context.Context, request *ListPersonExtraFieldEntriesRequest) (*PersonExtraFieldEntryIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "personExtraFieldBEntries")
+
+	it, err := rc.list(ctx, &uri)
+	return &PersonExtraFieldEntryIterator{it}, err
+}
@@ -0,0 +1,91 @@
+package topdesk
+
+import (
+	"context"
+	"path"
+)
+
+type PersonGroupIterator struct {
+	*ListIterator
+}
+
+func (i PersonGroupIterator) PersonGroup() (*PersonGroup, error) {
+	response := &PersonGroup{}
+	err := i.decode(&response)
+	return response, err
+}
+
+type PersonGroup struct {
+	ID        string `json:"id"`
+	GroupName string `json:"groupName"`
+	Status    string `json:"status"`
+	Branch    struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"branch"`
+	Location struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"location"`
+}
+
+func (g PersonGroup) Ref() *Ref {
+	return &Ref{ID: g.ID}
+}
+
+type ListPersonGroupsRequest struct{}
+
+func (rc RestClient) ListPersonGroups(ctx context.Context, request *ListPersonGroupsRequest) (*PersonGroupIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persongroups")
+
+	it, err := rc.list(ctx, &uri)
+	return &PersonGroupIterator{it}, err
+}
+
+func (rc RestClient) GetPersonGroup(ctx context.Context, id string) (*PersonGroup, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persongroups", "id", id)
+
+	response := &PersonGroup{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// ListPersonGroupMembers lists the persons in a person group.
+func (rc RestClient) ListPersonGroupMembers(ctx context.Context, id string) (*PeopleIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persongroups", "id", id, "persons")
+
+	it, err := rc.list(ctx, &uri)
+	return &PeopleIterator{it}, err
+}
+
+// ListPersonPersonGroups lists the person groups a person is a member of.
+func (rc RestClient) ListPersonPersonGroups(ctx context.Context, id string) (*PersonGroupIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persons", "id", id, "persongroups")
+
+	it, err := rc.list(ctx, &uri)
+	return &PersonGroupIterator{it}, err
+}
+
+// PersonGroupMembersRequest adds or removes persons from a person group.
+type PersonGroupMembersRequest struct {
+	ID      string
+	Persons []*Ref
+}
+
+func (rc RestClient) AddPersonGroupMembers(ctx context.Context, request *PersonGroupMembersRequest) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persongroups", "id", request.ID, "persons")
+
+	return rc.create(ctx, &uri, request.Persons, nil)
+}
+
+func (rc RestClient) RemovePersonGroupMembers(ctx context.Context, request *PersonGroupMembersRequest) error {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "persongroups", "id", request.ID, "persons")
+
+	return rc.delete(ctx, &uri, request.Persons)
+}
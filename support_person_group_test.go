@@ -0,0 +1,39 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestListPersonPersonGroups(t *testing.T) {
+	client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tas/api/persons/id/p1/persongroups" {
+			t.Errorf("got path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode([]PersonGroup{{ID: "g1", GroupName: "Staff"}, {ID: "g2", GroupName: "Managers"}})
+	})
+	defer server.Close()
+
+	groups, err := client.ListPersonPersonGroups(context.Background(), "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	for groups.Next() {
+		group, err := groups.PersonGroup()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, group.GroupName)
+	}
+	if err := groups.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != "Staff" || got[1] != "Managers" {
+		t.Errorf("got groups %v", got)
+	}
+}
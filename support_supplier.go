@@ -0,0 +1,271 @@
+package topdesk
+
+import (
+	"context"
+	"path"
+	"strconv"
+)
+
+type SupplierIterator struct {
+	*ListIterator
+}
+
+// Supplier decodes the listed supplier.
+func (i SupplierIterator) Supplier() (*Supplier, error) {
+	response := &Supplier{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Supplier structure.
+//
+// https://developers.topdesk.com/explorer/?page=supporting-files#/Suppliers/getSuppliers
+type Supplier struct {
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	ForFirstLine  bool          `json:"forFirstLine"`
+	ForSecondLine bool          `json:"forSecondLine"`
+	Phone         string        `json:"phone"`
+	Fax           string        `json:"fax"`
+	Email         string        `json:"email"`
+	Website       string        `json:"website"`
+	Address       BranchAddress `json:"address"`
+	PostalAddress BranchAddress `json:"postalAddress"`
+	Archived      bool          `json:"archived"`
+}
+
+func (s Supplier) Ref() *Ref {
+	return &Ref{ID: s.ID}
+}
+
+// ListSuppliersRequest filters suppliers, empty fields are ignored.
+//
+// ForFirstLine and ForSecondLine match Incident.Supplier eligibility. Query is a FIQL expression and'ed with the
+// other filters.
+type ListSuppliersRequest struct {
+	Name          string
+	ForFirstLine  *bool
+	ForSecondLine *bool
+	Archived      *bool
+	Query         string
+}
+
+func (rc RestClient) ListSuppliers(ctx context.Context, request *ListSuppliersRequest) (*SupplierIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "suppliers")
+
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.eq("name", request.Name)
+		if request.ForFirstLine != nil {
+			filter = filter.eq("forFirstLine", strconv.FormatBool(*request.ForFirstLine))
+		}
+		if request.ForSecondLine != nil {
+			filter = filter.eq("forSecondLine", strconv.FormatBool(*request.ForSecondLine))
+		}
+		filter = filter.and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		if request.Archived != nil {
+			query.Set("archived", strconv.FormatBool(*request.Archived))
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.list(ctx, &uri)
+	return &SupplierIterator{it}, err
+}
+
+func (rc RestClient) GetSupplier(ctx context.Context, id string) (*Supplier, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "suppliers", "id", id)
+
+	response := &Supplier{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateSupplierRequest creates a supplier, Name is required.
+type CreateSupplierRequest struct {
+	Name          string                `json:"name"`
+	ForFirstLine  bool                  `json:"forFirstLine,omitempty"`
+	ForSecondLine bool                  `json:"forSecondLine,omitempty"`
+	Phone         string                `json:"phone,omitempty"`
+	Fax           string                `json:"fax,omitempty"`
+	Email         string                `json:"email,omitempty"`
+	Website       string                `json:"website,omitempty"`
+	Address       *BranchAddressRequest `json:"address,omitempty"`
+	PostalAddress *BranchAddressRequest `json:"postalAddress,omitempty"`
+}
+
+func (rc RestClient) CreateSupplier(ctx context.Context, request *CreateSupplierRequest) (*Supplier, error) {
+	if err := rc.validateBranchCountries(ctx, request.Address, request.PostalAddress); err != nil {
+		return nil, err
+	}
+
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "suppliers")
+
+	response := &Supplier{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateSupplierRequest patches a supplier, only non-zero fields are sent.
+type UpdateSupplierRequest struct {
+	ID            string                `json:"-"`
+	Name          string                `json:"name,omitempty"`
+	ForFirstLine  *bool                 `json:"forFirstLine,omitempty"`
+	ForSecondLine *bool                 `json:"forSecondLine,omitempty"`
+	Phone         string                `json:"phone,omitempty"`
+	Fax           string                `json:"fax,omitempty"`
+	Email         string                `json:"email,omitempty"`
+	Website       string                `json:"website,omitempty"`
+	Address       *BranchAddressRequest `json:"address,omitempty"`
+	PostalAddress *BranchAddressRequest `json:"postalAddress,omitempty"`
+}
+
+func (rc RestClient) UpdateSupplier(ctx context.Context, request *UpdateSupplierRequest) (*Supplier, error) {
+	if err := rc.validateBranchCountries(ctx, request.Address, request.PostalAddress); err != nil {
+		return nil, err
+	}
+
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "suppliers", "id", request.ID)
+
+	response := &Supplier{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+type SupplierContactIterator struct {
+	*ListIterator
+}
+
+// SupplierContact decodes the listed supplier contact.
+func (i SupplierContactIterator) SupplierContact() (*SupplierContact, error) {
+	response := &SupplierContact{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// SupplierContact is a person at a supplier.
+type SupplierContact struct {
+	ID        string `json:"id"`
+	Surname   string `json:"surName"`
+	FirstName string `json:"firstName"`
+	Prefixes  string `json:"prefixes"`
+	Title     string `json:"title"`
+	JobTitle  string `json:"jobTitle"`
+	Phone     string `json:"phoneNumber"`
+	Mobile    string `json:"mobileNumber"`
+	Email     string `json:"email"`
+	Supplier  struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"supplier"`
+	Archived bool `json:"archived"`
+}
+
+func (c SupplierContact) Ref() *Ref {
+	return &Ref{ID: c.ID}
+}
+
+// ListSupplierContactsRequest filters supplier contacts, empty fields are ignored.
+type ListSupplierContactsRequest struct {
+	SupplierID string
+	Email      string
+	Archived   *bool
+	Query      string
+}
+
+func (rc RestClient) ListSupplierContacts(ctx context.Context, request *ListSupplierContactsRequest) (*SupplierContactIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "supplierContacts")
+
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.eq("supplier.id", request.SupplierID).eq("email", request.Email).and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		if request.Archived != nil {
+			query.Set("archived", strconv.FormatBool(*request.Archived))
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.list(ctx, &uri)
+	return &SupplierContactIterator{it}, err
+}
+
+func (rc RestClient) GetSupplierContact(ctx context.Context, id string) (*SupplierContact, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "supplierContacts", "id", id)
+
+	response := &SupplierContact{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateSupplierContactRequest creates a supplier contact, Supplier and Surname are required.
+type CreateSupplierContactRequest struct {
+	Supplier  *Ref   `json:"supplier"`
+	Surname   string `json:"surName"`
+	FirstName string `json:"firstName,omitempty"`
+	Prefixes  string `json:"prefixes,omitempty"`
+	Title     string `json:"title,omitempty"`
+	JobTitle  string `json:"jobTitle,omitempty"`
+	Phone     string `json:"phoneNumber,omitempty"`
+	Mobile    string `json:"mobileNumber,omitempty"`
+	Email     string `json:"email,omitempty"`
+}
+
+func (rc RestClient) CreateSupplierContact(ctx context.Context, request *CreateSupplierContactRequest) (*SupplierContact, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "supplierContacts")
+
+	response := &SupplierContact{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateSupplierContactRequest patches a supplier contact, only non-zero fields are sent.
+type UpdateSupplierContactRequest struct {
+	ID        string `json:"-"`
+	Supplier  *Ref   `json:"supplier,omitempty"`
+	Surname   string `json:"surName,omitempty"`
+	FirstName string `json:"firstName,omitempty"`
+	Prefixes  string `json:"prefixes,omitempty"`
+	Title     string `json:"title,omitempty"`
+	JobTitle  string `json:"jobTitle,omitempty"`
+	Phone     string `json:"phoneNumber,omitempty"`
+	Mobile    string `json:"mobileNumber,omitempty"`
+	Email     string `json:"email,omitempty"`
+}
+
+func (rc RestClient) UpdateSupplierContact(ctx context.Context, request *UpdateSupplierContactRequest) (*SupplierContact, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "supplierContacts", "id", request.ID)
+
+	response := &SupplierContact{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
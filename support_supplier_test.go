@@ -0,0 +1,38 @@
+package topdesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSupplierValidatesCountries(t *testing.T) {
+	saves := 0
+	client, server := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tas/api/countries":
+			json.NewEncoder(w).Encode([]Country{{ID: "nl", Name: "Nederland"}})
+		default:
+			saves++
+			json.NewEncoder(w).Encode(Supplier{ID: "s1"})
+		}
+	})
+	defer server.Close()
+
+	if _, err := client.CreateSupplier(context.Background(), &CreateSupplierRequest{
+		Name:    "Acme",
+		Address: &BranchAddressRequest{Country: &Ref{ID: "nl"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.UpdateSupplier(context.Background(), &UpdateSupplierRequest{
+		ID:            "s1",
+		PostalAddress: &BranchAddressRequest{Country: &Ref{ID: "xx"}},
+	}); err == nil {
+		t.Error("unknown country got nil error")
+	}
+	if saves != 1 {
+		t.Errorf("got %d saves, want 1", saves)
+	}
+}
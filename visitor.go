@@ -0,0 +1,205 @@
+package topdesk
+
+// Visitor registration.
+//
+// https://developers.topdesk.com/explorer/?page=visitors
+
+import (
+	"context"
+	"path"
+	"time"
+)
+
+type VisitorIterator struct {
+	*ListIterator
+}
+
+// Visitor decodes the listed visitor registration.
+func (i VisitorIterator) Visitor() (*Visitor, error) {
+	response := &Visitor{}
+	if err := i.decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type VisitorStatus string
+
+const (
+	VisitorStatusExpected   VisitorStatus = "expected"
+	VisitorStatusCheckedIn  VisitorStatus = "checkedIn"
+	VisitorStatusCheckedOut VisitorStatus = "checkedOut"
+	VisitorStatusCancelled  VisitorStatus = "cancelled"
+)
+
+// VisitorBadge handed out at the front desk.
+type VisitorBadge struct {
+	Number   string `json:"number,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Returned bool   `json:"returned,omitempty"`
+}
+
+// Visitor registration, Host is the Person being visited.
+//
+// Host, Branch and Location are partial, only the ID and name (where the type has one) are set.
+type Visitor struct {
+	ID                string        `json:"id"`
+	Number            string        `json:"number"`
+	Status            VisitorStatus `json:"status"`
+	Name              string        `json:"visitorName"`
+	Organization      string        `json:"organization"`
+	Email             string        `json:"email"`
+	Phone             string        `json:"telephone"`
+	Host              Person        `json:"host"`
+	Branch            Branch        `json:"branch"`
+	Location          Location      `json:"location"`
+	ExpectedArrival   string        `json:"expectedArrival"`
+	ExpectedDeparture string        `json:"expectedDeparture"`
+	Arrival           string        `json:"arrival"`
+	Departure         string        `json:"departure"`
+	Badge             VisitorBadge  `json:"badge"`
+	Remarks           string        `json:"remarks"`
+	CreationDate      string        `json:"creationDate"`
+	ModificationDate  string        `json:"modificationDate"`
+}
+
+func (v Visitor) Ref() *Ref {
+	return &Ref{ID: v.ID}
+}
+
+// ListVisitorsRequest filters visitors, empty fields are ignored.
+//
+// From and To select visitors expected to arrive in the range. Query is a FIQL expression and'ed with the other
+// filters.
+type ListVisitorsRequest struct {
+	HostID     string
+	BranchID   string
+	LocationID string
+	Status     VisitorStatus
+	From       time.Time
+	To         time.Time
+	Query      string
+}
+
+func (rc RestClient) ListVisitors(ctx context.Context, request *ListVisitorsRequest) (*VisitorIterator, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "visitors")
+
+	if request != nil {
+		query := uri.Query()
+		filter := fiql{}.
+			eq("host.id", request.HostID).
+			eq("branch.id", request.BranchID).
+			eq("location.id", request.LocationID).
+			eq("status", string(request.Status))
+		if !request.From.IsZero() {
+			filter = filter.and(`expectedArrival=ge="` + FormatTime(request.From) + `"`)
+		}
+		if !request.To.IsZero() {
+			filter = filter.and(`expectedArrival=lt="` + FormatTime(request.To) + `"`)
+		}
+		filter = filter.and(request.Query)
+		if len(filter) > 0 {
+			query.Set("query", filter.String())
+		}
+		uri.RawQuery = query.Encode()
+	}
+
+	it, err := rc.list(ctx, &uri)
+	return &VisitorIterator{it}, err
+}
+
+// GetVisitor by ID or number.
+func (rc RestClient) GetVisitor(ctx context.Context, id string) (*Visitor, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "visitors", id)
+
+	response := &Visitor{}
+	err := rc.get(ctx, &uri, response)
+	return response, err
+}
+
+// CreateVisitorRequest pre-registers a visitor, Name and ExpectedArrival are required. Dates use FormatTime.
+type CreateVisitorRequest struct {
+	Name              string        `json:"visitorName"`
+	Organization      string        `json:"organization,omitempty"`
+	Email             string        `json:"email,omitempty"`
+	Phone             string        `json:"telephone,omitempty"`
+	Host              *Ref          `json:"host,omitempty"`
+	Branch            *Ref          `json:"branch,omitempty"`
+	Location          *Ref          `json:"location,omitempty"`
+	ExpectedArrival   string        `json:"expectedArrival"`
+	ExpectedDeparture string        `json:"expectedDeparture,omitempty"`
+	Badge             *VisitorBadge `json:"badge,omitempty"`
+	Remarks           string        `json:"remarks,omitempty"`
+}
+
+func (rc RestClient) CreateVisitor(ctx context.Context, request *CreateVisitorRequest) (*Visitor, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "visitors")
+
+	response := &Visitor{}
+	if err := rc.create(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateVisitorRequest patches a visitor, only non-zero fields are sent.
+type UpdateVisitorRequest struct {
+	ID                string        `json:"-"`
+	Name              string        `json:"visitorName,omitempty"`
+	Organization      string        `json:"organization,omitempty"`
+	Email             string        `json:"email,omitempty"`
+	Phone             string        `json:"telephone,omitempty"`
+	Host              *Ref          `json:"host,omitempty"`
+	Branch            *Ref          `json:"branch,omitempty"`
+	Location          *Ref          `json:"location,omitempty"`
+	ExpectedArrival   string        `json:"expectedArrival,omitempty"`
+	ExpectedDeparture string        `json:"expectedDeparture,omitempty"`
+	Badge             *VisitorBadge `json:"badge,omitempty"`
+	Remarks           string        `json:"remarks,omitempty"`
+}
+
+func (rc RestClient) UpdateVisitor(ctx context.Context, request *UpdateVisitorRequest) (*Visitor, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "visitors", request.ID)
+
+	response := &Visitor{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CheckInVisitor records arrival now, the badge is optional.
+func (rc RestClient) CheckInVisitor(ctx context.Context, id string, badge *VisitorBadge) (*Visitor, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "visitors", id, "checkin")
+
+	request := struct {
+		Badge *VisitorBadge `json:"badge,omitempty"`
+	}{badge}
+
+	response := &Visitor{}
+	if err := rc.patch(ctx, &uri, request, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// CheckOutVisitor records departure now.
+func (rc RestClient) CheckOutVisitor(ctx context.Context, id string) (*Visitor, error) {
+	uri := *rc.endpoint
+	uri.Path = path.Join(uri.Path, "visitors", id, "checkout")
+
+	response := &Visitor{}
+	if err := rc.patch(ctx, &uri, nil, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}